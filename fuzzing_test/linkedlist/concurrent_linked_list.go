@@ -0,0 +1,129 @@
+package linkedlist
+
+import (
+	"iter"
+	"slices"
+	"sync"
+)
+
+// ConcurrentLinkedList is LinkedList guarded by RWMutex for sharing between goroutines.
+// Node handles are not returned, they can not be used safely without the lock,
+// so lookups return values and Update gives access to the list under the lock
+type ConcurrentLinkedList struct {
+	mu   sync.RWMutex
+	list LinkedList
+}
+
+func NewConcurrentLinkedList(values ...int) *ConcurrentLinkedList {
+	var l ConcurrentLinkedList
+	l.AddAll(values...)
+	return &l
+}
+
+// t = O(1)
+func (l *ConcurrentLinkedList) AddInTail(value int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.list.AddInTail(Node{value: value})
+}
+
+// t = O(1)
+func (l *ConcurrentLinkedList) InsertFirst(value int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.list.InsertFirst(Node{value: value})
+}
+
+// t = O(n), where n = len(list)
+func (l *ConcurrentLinkedList) Delete(n int, all bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.list.Delete(n, all)
+}
+
+// t = O(1)
+func (l *ConcurrentLinkedList) PopFront() (int, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.list.PopFront()
+}
+
+// t = O(n), where n = len(list)
+func (l *ConcurrentLinkedList) Contains(n int) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	_, err := l.list.Find(n)
+	return err == nil
+}
+
+// returns copies of matching values
+// t = O(n), where n = len(list)
+func (l *ConcurrentLinkedList) FindAll(n int) []int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	nodes := l.list.FindAll(n)
+	values := make([]int, 0, len(nodes))
+	for _, node := range nodes {
+		values = append(values, node.value)
+	}
+	return values
+}
+
+// t = O(1)
+func (l *ConcurrentLinkedList) Count() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.list.Count()
+}
+
+// t = O(n), where n = len(list)
+func (l *ConcurrentLinkedList) Clean() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.list.Clean()
+}
+
+// batch operations hold the lock once for all values
+
+func (l *ConcurrentLinkedList) AddAll(values ...int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, value := range values {
+		l.list.AddInTail(Node{value: value})
+	}
+}
+
+func (l *ConcurrentLinkedList) DeleteAll(values []int, all bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, value := range values {
+		l.list.Delete(value, all)
+	}
+}
+
+// Update runs fn under the write lock, handles of the list must not leave fn
+func (l *ConcurrentLinkedList) Update(fn func(l *LinkedList)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	fn(&l.list)
+}
+
+// Snapshot copies values under the read lock
+// t = O(n), where n = len(list)
+func (l *ConcurrentLinkedList) Snapshot() []int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return slices.AppendSeq(make([]int, 0, l.list.Count()), l.list.All())
+}
+
+// All iterates consistent snapshot, the lock is not held while yielding,
+// so loop body may change the list
+func (l *ConcurrentLinkedList) All() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for _, value := range l.Snapshot() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
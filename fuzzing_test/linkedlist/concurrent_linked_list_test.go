@@ -0,0 +1,90 @@
+package linkedlist
+
+import (
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestConcurrentLinkedList(t *testing.T) {
+	list := NewConcurrentLinkedList(22, 3, 2)
+	list.InsertFirst(1)
+	list.AddAll(3, 3)
+	list.DeleteAll([]int{2, 22}, false)
+
+	if got, want := list.Snapshot(), []int{1, 3, 3, 3}; !slices.Equal(got, want) {
+		t.Errorf("failed: batch operations, got %v, want %v", got, want)
+	}
+	if got := list.FindAll(3); !slices.Equal(got, []int{3, 3, 3}) {
+		t.Errorf("failed: find all, got %v", got)
+	}
+	if list.Contains(22) || !list.Contains(1) {
+		t.Errorf("failed: contains")
+	}
+
+	// changing list inside range must not deadlock and must not change the snapshot
+	var seen []int
+	for value := range list.All() {
+		list.Delete(value, true)
+		seen = append(seen, value)
+	}
+	if !slices.Equal(seen, []int{1, 3, 3, 3}) || list.Count() != 0 {
+		t.Errorf("failed: snapshot iteration, seen %v, count %d", seen, list.Count())
+	}
+
+	list.Update(func(l *LinkedList) {
+		first := l.AddInTail(Node{value: 5})
+		l.InsertAfter(first, Node{value: 6})
+	})
+	if got := list.Snapshot(); !slices.Equal(got, []int{5, 6}) {
+		t.Errorf("failed: update, got %v", got)
+	}
+}
+
+// run with -race
+func TestConcurrentLinkedListStress(t *testing.T) {
+	const (
+		workers = 16
+		rounds  = 500
+	)
+	var list ConcurrentLinkedList
+	var wg sync.WaitGroup
+
+	for worker := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for round := range rounds {
+				value := worker*rounds + round
+				switch round % 4 {
+				case 0, 1:
+					list.AddInTail(value)
+				case 2:
+					list.Delete(value-1, false)
+				case 3:
+					for _, found := range list.FindAll(value - 3) {
+						if found != value-3 {
+							t.Errorf("failed: find all returned %d, want %d", found, value-3)
+						}
+					}
+					for range list.All() {
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	// every worker adds 2 values per 4 rounds and deletes one of them
+	if got, want := list.Count(), workers*rounds/4; got != want {
+		t.Errorf("failed: count after stress, got %d, want %d", got, want)
+	}
+	list.Update(func(l *LinkedList) {
+		if err := l.Validate(); err != nil {
+			t.Errorf("failed: list after stress, err %v", err)
+		}
+	})
+	if got := list.Snapshot(); len(got) != list.Count() {
+		t.Errorf("failed: snapshot length %d", len(got))
+	}
+}
@@ -0,0 +1,248 @@
+package linkedlist
+
+import (
+	"iter"
+)
+
+type DoublyNode[T any] struct {
+	prev  *DoublyNode[T]
+	next  *DoublyNode[T]
+	value T
+	list  *DoublyList[T] // owner list, nil after removing
+}
+
+func (n *DoublyNode[T]) Value() T {
+	return n.value
+}
+
+// DoublyList is a doubly linked list with prev links,
+// so removing by node handle and from the tail takes O(1)
+type DoublyList[T any] struct {
+	head   *DoublyNode[T]
+	tail   *DoublyNode[T]
+	length int
+	equal  func(a, b T) bool
+}
+
+func NewDoublyList[T comparable]() *DoublyList[T] {
+	return NewDoublyListFunc(func(a, b T) bool {
+		return a == b
+	})
+}
+
+func NewDoublyListFunc[T any](equal func(a, b T) bool) *DoublyList[T] {
+	return &DoublyList[T]{equal: equal}
+}
+
+func GetDoublyList[T comparable](values []T) *DoublyList[T] {
+	return GetDoublyListFunc(values, func(a, b T) bool {
+		return a == b
+	})
+}
+
+// GetDoublyListFunc is GetDoublyList for values, which are not comparable by ==
+func GetDoublyListFunc[T any](values []T, equal func(a, b T) bool) *DoublyList[T] {
+	resultL := NewDoublyListFunc(equal) // resulting linked list
+	for _, value := range values {
+		resultL.AddInTail(value)
+	}
+	return resultL
+}
+
+func (l *DoublyList[T]) matches(n T) func(T) bool {
+	if l.equal == nil {
+		return func(value T) bool {
+			return any(value) == any(n)
+		}
+	}
+	return func(value T) bool {
+		return l.equal(value, n)
+	}
+}
+
+// t = O(1)
+func (l *DoublyList[T]) AddInTail(value T) *DoublyNode[T] {
+	node := &DoublyNode[T]{value: value, list: l}
+	if l.head == nil {
+		l.head = node
+	} else {
+		node.prev = l.tail
+		l.tail.next = node
+	}
+	l.tail = node
+	l.length++
+	return node
+}
+
+// t = O(1)
+func (l *DoublyList[T]) InsertFirst(value T) *DoublyNode[T] {
+	node := &DoublyNode[T]{value: value, list: l}
+	if l.head == nil {
+		l.tail = node
+	} else {
+		node.next = l.head
+		l.head.prev = node
+	}
+	l.head = node
+	l.length++
+	return node
+}
+
+// t = O(1)
+func (l *DoublyList[T]) Count() int {
+	return l.length
+}
+
+func (l *DoublyList[T]) Find(n T) (*DoublyNode[T], error) {
+	match := l.matches(n)
+	for tempNode := l.head; tempNode != nil; tempNode = tempNode.next {
+		if match(tempNode.value) {
+			return tempNode, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// t = O(n), where n = len(list)
+func (l *DoublyList[T]) FindAll(n T) []*DoublyNode[T] {
+	var nodes []*DoublyNode[T]
+	match := l.matches(n)
+	for tempNode := l.head; tempNode != nil; tempNode = tempNode.next {
+		if match(tempNode.value) {
+			nodes = append(nodes, tempNode)
+		}
+	}
+	return nodes
+}
+
+// t = O(n), where n = len(list)
+func (l *DoublyList[T]) Delete(n T, all bool) {
+	match := l.matches(n)
+	tempNode := l.head
+	for tempNode != nil {
+		next := tempNode.next
+		if match(tempNode.value) {
+			l.unlink(tempNode)
+			if !all {
+				return
+			}
+		}
+		tempNode = next
+	}
+}
+
+// t = O(1)
+func (l *DoublyList[T]) Remove(node *DoublyNode[T]) error {
+	if node == nil || node.list != l {
+		return ErrForeignNode
+	}
+	l.unlink(node)
+	return nil
+}
+
+// t = O(1)
+func (l *DoublyList[T]) MoveToFront(node *DoublyNode[T]) error {
+	if node == nil || node.list != l {
+		return ErrForeignNode
+	}
+	if node == l.head {
+		return nil
+	}
+	node.prev.next = node.next
+	if node == l.tail {
+		l.tail = node.prev
+	} else {
+		node.next.prev = node.prev
+	}
+	node.prev, node.next = nil, l.head
+	l.head.prev = node
+	l.head = node
+	return nil
+}
+
+func (l *DoublyList[T]) unlink(node *DoublyNode[T]) {
+	if node.prev == nil {
+		l.head = node.next
+	} else {
+		node.prev.next = node.next
+	}
+	if node.next == nil {
+		l.tail = node.prev
+	} else {
+		node.next.prev = node.prev
+	}
+	node.prev, node.next, node.list = nil, nil, nil
+	l.length--
+}
+
+// t = O(1)
+func (l *DoublyList[T]) PopFront() (T, bool) {
+	if l.head == nil {
+		var zero T
+		return zero, false
+	}
+	node := l.head
+	l.unlink(node)
+	return node.value, true
+}
+
+// t = O(1)
+func (l *DoublyList[T]) PopBack() (T, bool) {
+	if l.tail == nil {
+		var zero T
+		return zero, false
+	}
+	node := l.tail
+	l.unlink(node)
+	return node.value, true
+}
+
+// t = O(n), where n = len(list)
+func (l *DoublyList[T]) Clean() {
+	// detach nodes, so old handles can not remove anything
+	for tempNode := l.head; tempNode != nil; {
+		next := tempNode.next
+		tempNode.prev, tempNode.next, tempNode.list = nil, nil, nil
+		tempNode = next
+	}
+	l.head = nil
+	l.tail = nil
+	l.length = 0
+}
+
+func (l *DoublyList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for tempNode := l.head; tempNode != nil; tempNode = tempNode.next {
+			if !yield(tempNode.value) {
+				return
+			}
+		}
+	}
+}
+
+func (l *DoublyList[T]) Values() iter.Seq[T] {
+	return l.All()
+}
+
+func (l *DoublyList[T]) Enumerate() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		indx := 0
+		for tempNode := l.head; tempNode != nil; tempNode = tempNode.next {
+			if !yield(indx, tempNode.value) {
+				return
+			}
+			indx++
+		}
+	}
+}
+
+// reverse traversal from tail to head by prev links
+func (l *DoublyList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for tempNode := l.tail; tempNode != nil; tempNode = tempNode.prev {
+			if !yield(tempNode.value) {
+				return
+			}
+		}
+	}
+}
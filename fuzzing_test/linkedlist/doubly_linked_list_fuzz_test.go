@@ -0,0 +1,214 @@
+package linkedlist
+
+import (
+	"testing"
+)
+
+// checks head/tail, length, cycles and prev/next symmetry
+func checkDoublyList(t *testing.T, l *DoublyList[int], want []int) {
+	t.Helper()
+
+	if len(want) == 0 {
+		if l.head != nil || l.tail != nil {
+			t.Fatalf("empty list must have nil head/tail")
+		}
+		return
+	}
+	if l.head == nil || l.tail == nil {
+		t.Fatalf("non-empty list must have non-nil head/tail")
+	}
+	if l.head.prev != nil {
+		t.Fatalf("head.prev must be nil")
+	}
+	if l.tail.next != nil {
+		t.Fatalf("tail.next must be nil")
+	}
+
+	steps := 0
+	var prev *DoublyNode[int]
+	for n := l.head; n != nil; n = n.next {
+		if steps >= len(want) {
+			t.Fatalf("possible cycle or extra nodes, steps=%d len=%d", steps+1, len(want))
+		}
+		if n.prev != prev {
+			t.Fatalf("prev/next asymmetry at %d", steps)
+		}
+		if n.list != l {
+			t.Fatalf("node at %d has wrong owner list", steps)
+		}
+		if n.value != want[steps] {
+			t.Fatalf("order mismatch at %d: got=%d want=%d want list=%v", steps, n.value, want[steps], want)
+		}
+		prev = n
+		steps++
+	}
+	if steps != len(want) {
+		t.Fatalf("traversal len mismatch: got=%d want=%d", steps, len(want))
+	}
+	if prev != l.tail {
+		t.Fatalf("last node is not tail")
+	}
+
+	steps = len(want) - 1
+	for n := l.tail; n != nil; n = n.prev {
+		if steps < 0 {
+			t.Fatalf("possible cycle in prev links")
+		}
+		if n.value != want[steps] {
+			t.Fatalf("backward order mismatch at %d: got=%d want=%d", steps, n.value, want[steps])
+		}
+		steps--
+	}
+	if steps != -1 {
+		t.Fatalf("backward traversal len mismatch")
+	}
+
+	if got, want := l.Count(), len(want); got != want {
+		t.Fatalf("Count mismatch: got=%d want=%d", got, want)
+	}
+	if err := l.Validate(); err != nil {
+		t.Fatalf("%v", err)
+	}
+}
+
+func FuzzDoublyAddInTail(f *testing.F) {
+	f.Add(intsToBytes([]int{}))
+	f.Add(intsToBytes([]int{1}))
+	f.Add(intsToBytes([]int{1, 2, 3}))
+	f.Add(intsToBytes([]int{0, 0, 0, 0, 0}))
+	f.Add(intsToBytes([]int{-1, 0, 1, -1, 32767, -32768}))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if len(data) > 3000 {
+			t.Skip()
+		}
+		ints := bytesToInts(data, 200)
+
+		var l DoublyList[int]
+		for _, v := range ints {
+			l.AddInTail(v)
+		}
+		checkDoublyList(t, &l, ints)
+
+		var first DoublyList[int]
+		for i := len(ints) - 1; i >= 0; i-- {
+			first.InsertFirst(ints[i])
+		}
+		checkDoublyList(t, &first, ints)
+	})
+}
+
+func FuzzDoublyList_Delete(f *testing.F) {
+	f.Add(intsToBytes([]int{}), 1, false)
+	f.Add(intsToBytes([]int{1, 2, 3}), 2, false)
+	f.Add(intsToBytes([]int{1, 2, 2, 3}), 2, true)
+	f.Add(intsToBytes([]int{5}), 5, false)
+	f.Add(intsToBytes([]int{7, 7, 7}), 7, false)
+	f.Add(intsToBytes([]int{-1, 0, -1, 0}), -1, true)
+
+	f.Fuzz(func(t *testing.T, data []byte, target int, all bool) {
+		if len(data) > 3000 {
+			t.Skip()
+		}
+		ints := bytesToInts(data, 300)
+		list := GetDoublyList(ints)
+
+		list.Delete(target, all)
+
+		want := make([]int, 0, len(ints))
+		deleted := false
+		for _, v := range ints {
+			if v == target {
+				if all {
+					continue
+				}
+				if !deleted {
+					deleted = true
+					continue
+				}
+			}
+			want = append(want, v)
+		}
+		checkDoublyList(t, list, want)
+	})
+}
+
+func FuzzDoublyList_RemovePop(f *testing.F) {
+	f.Add(intsToBytes([]int{1}), []byte{0})
+	f.Add(intsToBytes([]int{1, 2, 3}), []byte{1, 0, 0})
+	f.Add(intsToBytes([]int{5, 6, 7, 8}), []byte{3, 2, 1, 0})
+
+	// ops: 0 - PopFront, 1 - PopBack, other - Remove node by index
+	f.Fuzz(func(t *testing.T, data []byte, ops []byte) {
+		if len(data) > 3000 || len(ops) > 300 {
+			t.Skip()
+		}
+		ints := bytesToInts(data, 300)
+
+		list := NewDoublyList[int]()
+		nodes := make([]*DoublyNode[int], 0, len(ints))
+		for _, v := range ints {
+			nodes = append(nodes, list.AddInTail(v))
+		}
+
+		model := make([]int, len(ints))
+		for i := range model {
+			model[i] = i // model keeps indexes of alive nodes
+		}
+
+		for _, op := range ops {
+			switch op % 3 {
+			case 0:
+				value, ok := list.PopFront()
+				if ok != (len(model) > 0) {
+					t.Fatalf("PopFront ok=%v with model len=%d", ok, len(model))
+				}
+				if ok {
+					if value != ints[model[0]] {
+						t.Fatalf("PopFront got=%d want=%d", value, ints[model[0]])
+					}
+					model = model[1:]
+				}
+			case 1:
+				value, ok := list.PopBack()
+				if ok != (len(model) > 0) {
+					t.Fatalf("PopBack ok=%v with model len=%d", ok, len(model))
+				}
+				if ok {
+					if value != ints[model[len(model)-1]] {
+						t.Fatalf("PopBack got=%d want=%d", value, ints[model[len(model)-1]])
+					}
+					model = model[:len(model)-1]
+				}
+			default:
+				if len(nodes) == 0 {
+					continue
+				}
+				index := int(op) % len(nodes)
+				alive := -1
+				for i, m := range model {
+					if m == index {
+						alive = i
+					}
+				}
+				err := list.Remove(nodes[index])
+				if alive == -1 {
+					if err == nil {
+						t.Fatalf("Remove of stale node %d must fail", index)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatalf("Remove of alive node %d failed: %v", index, err)
+				}
+				model = append(model[:alive], model[alive+1:]...)
+			}
+
+			want := make([]int, 0, len(model))
+			for _, m := range model {
+				want = append(want, ints[m])
+			}
+			checkDoublyList(t, list, want)
+		}
+	})
+}
@@ -0,0 +1,141 @@
+package linkedlist
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDoublyRemove(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []int
+		remove int // index of removing node
+		want   []int
+	}{
+		{"Test1: ", []int{1}, 0, []int{}},
+		{"Test2: ", []int{1, 2, 3}, 0, []int{2, 3}},
+		{"Test3: ", []int{1, 2, 3}, 1, []int{1, 3}},
+		{"Test4: ", []int{1, 2, 3}, 2, []int{1, 2}},
+	}
+
+	for _, test := range tests {
+		list := NewDoublyList[int]()
+		nodes := make([]*DoublyNode[int], 0, len(test.input))
+		for _, value := range test.input {
+			nodes = append(nodes, list.AddInTail(value))
+		}
+
+		if err := list.Remove(nodes[test.remove]); err != nil {
+			t.Fatalf("failed %s: remove node, err %v", test.name, err)
+		}
+		if got := slices.Collect(list.All()); !slices.Equal(got, test.want) {
+			t.Errorf("failed %s: remove node, got %v, want %v", test.name, got, test.want)
+		}
+		if got := slices.Collect(list.Backward()); !slices.Equal(got, reversed(test.want)) {
+			t.Errorf("failed %s: backward after remove, got %v", test.name, got)
+		}
+		if err := list.Remove(nodes[test.remove]); err == nil {
+			t.Errorf("failed %s: removing stale node without error", test.name)
+		}
+	}
+}
+
+func TestDoublyRemoveForeignNode(t *testing.T) {
+	l1, l2 := GetDoublyList([]int{1, 2}), GetDoublyList([]int{1, 2})
+	foreign, _ := l2.Find(1)
+
+	if err := l1.Remove(foreign); err == nil {
+		t.Errorf("failed: removing foreign node without error")
+	}
+	if err := l1.Remove(nil); err == nil {
+		t.Errorf("failed: removing nil node without error")
+	}
+	if got := slices.Collect(l1.All()); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("failed: foreign remove changed list, got %v", got)
+	}
+}
+
+func TestDoublyPop(t *testing.T) {
+	list := GetDoublyList([]int{1, 2, 3})
+	list.InsertFirst(0)
+
+	if value, ok := list.PopBack(); !ok || value != 3 {
+		t.Errorf("failed: pop back, got %d %v", value, ok)
+	}
+	if value, ok := list.PopFront(); !ok || value != 0 {
+		t.Errorf("failed: pop front, got %d %v", value, ok)
+	}
+	if got := slices.Collect(list.All()); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("failed: pop, got %v", got)
+	}
+
+	list.Clean()
+	if _, ok := list.PopFront(); ok {
+		t.Errorf("failed: pop front from empty list")
+	}
+	if _, ok := list.PopBack(); ok {
+		t.Errorf("failed: pop back from empty list")
+	}
+}
+
+func TestDoublyDelete(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []int
+		values []int
+		all    bool
+		want   []int
+	}{
+		{"Test1: ", []int{}, []int{6}, false, []int{}},
+		{"Test2: ", []int{22, 2, 77, 6, 22, 76, 77, 89}, []int{22, 77}, true, []int{2, 6, 76, 89}},
+		{"Test3: ", []int{1}, []int{1}, false, []int{}},
+		{"Test4: ", []int{22, 2, 77, 6, 22, 76, 89}, []int{6, 2, 89}, false, []int{22, 77, 22, 76}},
+	}
+
+	for _, test := range tests {
+		list := GetDoublyList(test.input)
+		for _, value := range test.values {
+			list.Delete(value, test.all)
+		}
+		if got := slices.Collect(list.All()); !slices.Equal(got, test.want) {
+			t.Errorf("failed %s: deleting node with values %v, got %v", test.name, test.values, got)
+		}
+	}
+}
+
+func TestGetDoublyListFunc(t *testing.T) {
+	list := GetDoublyListFunc([][]int{{1}, {2, 3}, {1}}, slices.Equal[[]int])
+	list.Delete([]int{1}, true)
+	if got := slices.Collect(list.All()); len(got) != 1 || !slices.Equal(got[0], []int{2, 3}) {
+		t.Errorf("failed: deleting slices, got %v", got)
+	}
+}
+
+func reversed(values []int) []int {
+	out := slices.Clone(values)
+	slices.Reverse(out)
+	return out
+}
+
+func TestDoublyMoveToFront(t *testing.T) {
+	list := NewDoublyList[int]()
+	nodes := make([]*DoublyNode[int], 0, 3)
+	for value := range 3 {
+		nodes = append(nodes, list.AddInTail(value))
+	}
+
+	for _, move := range []int{2, 1, 1, 0} {
+		if err := list.MoveToFront(nodes[move]); err != nil {
+			t.Fatalf("failed: move to front, err %v", err)
+		}
+		if err := list.Validate(); err != nil || list.head != nodes[move] {
+			t.Fatalf("failed: move %d to front, err %v", move, err)
+		}
+	}
+	if got := slices.Collect(list.All()); !slices.Equal(got, []int{0, 1, 2}) {
+		t.Errorf("failed: move to front, got %v", got)
+	}
+	if err := list.MoveToFront(GetDoublyList([]int{1}).head); err == nil {
+		t.Errorf("failed: moving foreign node without error")
+	}
+}
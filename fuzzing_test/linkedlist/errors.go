@@ -0,0 +1,53 @@
+package linkedlist
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNotFound is returned when no node matches the value
+	ErrNotFound = errors.New("node is not finding")
+	// ErrLengthMismatch is matched by LengthMismatchError with errors.Is
+	ErrLengthMismatch = errors.New("different lengths")
+	// ErrOutOfRange is returned for index outside of the list
+	ErrOutOfRange = errors.New("index out of range")
+	// ErrForeignNode is returned for handle which is not from this list:
+	// node of other list, node built by hand or already removed one
+	ErrForeignNode = errors.New("node is not from this list")
+	// ErrBrokenList is wrapped by Validate with the broken invariant
+	ErrBrokenList = errors.New("list invariant is broken")
+	// ErrOverflow is matched by OverflowError with errors.Is
+	ErrOverflow = errors.New("integer overflow")
+	// ErrInvalidEncoding is returned by UnmarshalBinary for malformed data
+	ErrInvalidEncoding = errors.New("invalid list encoding")
+)
+
+// LengthMismatchError keeps both lengths of lists for element-wise operations
+type LengthMismatchError struct {
+	Len1 int
+	Len2 int
+}
+
+func (e *LengthMismatchError) Error() string {
+	return fmt.Sprintf("%s: %d and %d", ErrLengthMismatch, e.Len1, e.Len2)
+}
+
+func (e *LengthMismatchError) Is(target error) bool {
+	return target == ErrLengthMismatch
+}
+
+// OverflowError keeps operation and position where a value overflowed,
+// int for arithmetic and int16 for binary encoding
+type OverflowError struct {
+	Op    string
+	Index int
+}
+
+func (e *OverflowError) Error() string {
+	return fmt.Sprintf("%s: %s at index %d", ErrOverflow, e.Op, e.Index)
+}
+
+func (e *OverflowError) Is(target error) bool {
+	return target == ErrOverflow
+}
@@ -1,3 +1,7 @@
 module fuzzing
 
 go 1.25.5
+
+require minimize_steps v0.0.0
+
+replace minimize_steps => ../../minimize_steps
@@ -0,0 +1,81 @@
+// Package lfqueue is a lock-free FIFO queue by Michael and Scott,
+// nodes are linked like linkedlist.Node, but next is an atomic pointer
+package lfqueue
+
+import (
+	"sync/atomic"
+)
+
+type node[T any] struct {
+	next  atomic.Pointer[node[T]]
+	value T
+}
+
+// Queue keeps a dummy node in head, so Enqueue and Dequeue
+// work with different ends and never block each other.
+// Zero value is not ready to use, queue is created by New
+type Queue[T any] struct {
+	head   atomic.Pointer[node[T]]
+	tail   atomic.Pointer[node[T]]
+	length atomic.Int64
+}
+
+func New[T any]() *Queue[T] {
+	q := &Queue[T]{}
+	dummy := &node[T]{}
+	q.head.Store(dummy)
+	q.tail.Store(dummy)
+	return q
+}
+
+func (q *Queue[T]) Enqueue(value T) {
+	add := &node[T]{value: value}
+	for {
+		tail := q.tail.Load()
+		next := tail.next.Load()
+		if tail != q.tail.Load() {
+			continue
+		}
+		if next != nil {
+			// tail is behind, help other goroutine to move it
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		if tail.next.CompareAndSwap(nil, add) {
+			q.tail.CompareAndSwap(tail, add)
+			q.length.Add(1)
+			return
+		}
+	}
+}
+
+func (q *Queue[T]) Dequeue() (T, bool) {
+	for {
+		head := q.head.Load()
+		tail := q.tail.Load()
+		next := head.next.Load()
+		if head != q.head.Load() {
+			continue
+		}
+		if next == nil {
+			var zero T
+			return zero, false
+		}
+		if head == tail {
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		// value is read before CAS, after it next is a dummy for other goroutines
+		value := next.value
+		if q.head.CompareAndSwap(head, next) {
+			q.length.Add(-1)
+			return value, true
+		}
+	}
+}
+
+// Len is exact without concurrent operations,
+// under concurrency it is a value at some moment of the call
+func (q *Queue[T]) Len() int {
+	return int(max(q.length.Load(), 0))
+}
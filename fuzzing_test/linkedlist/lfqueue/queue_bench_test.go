@@ -0,0 +1,49 @@
+package lfqueue
+
+import (
+	"testing"
+
+	linkedlist "fuzzing"
+)
+
+// pairs of enqueue and dequeue from all procs, in style of a hot path
+
+func BenchmarkQueue(b *testing.B) {
+	q := New[int]()
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			q.Enqueue(1)
+			q.Dequeue()
+		}
+	})
+}
+
+func BenchmarkMutexLinkedList(b *testing.B) {
+	var l linkedlist.ConcurrentLinkedList
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			l.AddInTail(1)
+			l.PopFront()
+		}
+	})
+}
+
+func BenchmarkQueueSingle(b *testing.B) {
+	q := New[int]()
+	b.ReportAllocs()
+	for b.Loop() {
+		q.Enqueue(1)
+		q.Dequeue()
+	}
+}
+
+func BenchmarkMutexLinkedListSingle(b *testing.B) {
+	var l linkedlist.ConcurrentLinkedList
+	b.ReportAllocs()
+	for b.Loop() {
+		l.AddInTail(1)
+		l.PopFront()
+	}
+}
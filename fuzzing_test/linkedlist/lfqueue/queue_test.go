@@ -0,0 +1,227 @@
+package lfqueue
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestQueue(t *testing.T) {
+	q := New[int]()
+	if _, ok := q.Dequeue(); ok || q.Len() != 0 {
+		t.Fatalf("failed: dequeue from empty queue")
+	}
+
+	for value := range 5 {
+		q.Enqueue(value)
+	}
+	if q.Len() != 5 {
+		t.Errorf("failed: len after enqueue, got %d", q.Len())
+	}
+
+	var got []int
+	for value, ok := q.Dequeue(); ok; value, ok = q.Dequeue() {
+		got = append(got, value)
+	}
+	if !slices.Equal(got, []int{0, 1, 2, 3, 4}) || q.Len() != 0 {
+		t.Errorf("failed: fifo order, got %v, len %d", got, q.Len())
+	}
+}
+
+type opKind int
+
+const (
+	opEnqueue opKind = iota
+	opDequeue
+)
+
+// operation of concurrent history, call and ret are ticks of the shared clock
+type operation struct {
+	kind  opKind
+	value int
+	ok    bool
+	call  int64
+	ret   int64
+}
+
+// runHistory runs ops[g] in goroutine g and records every call and return
+func runHistory(q *Queue[int], ops [][]operation) []operation {
+	var clock atomic.Int64
+	var wg sync.WaitGroup
+	for g := range ops {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range ops[g] {
+				op := &ops[g][i]
+				op.call = clock.Add(1)
+				switch op.kind {
+				case opEnqueue:
+					q.Enqueue(op.value)
+				case opDequeue:
+					op.value, op.ok = q.Dequeue()
+				}
+				op.ret = clock.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	var history []operation
+	for g := range ops {
+		history = append(history, ops[g]...)
+	}
+	return history
+}
+
+// linearizable searches order of operations in style of Wing and Gong:
+// next operation is one of not done ones that was called before
+// every not done operation returned, and it must give the same result
+// on the sequential model []int
+func linearizable(history []operation) bool {
+	done := make([]bool, len(history))
+	var search func(model []int, left int) bool
+	search = func(model []int, left int) bool {
+		if left == 0 {
+			return true
+		}
+		minRet := int64(1 << 62)
+		for i, op := range history {
+			if !done[i] {
+				minRet = min(minRet, op.ret)
+			}
+		}
+		for i, op := range history {
+			if done[i] || op.call > minRet {
+				continue
+			}
+			next := model
+			switch {
+			case op.kind == opEnqueue:
+				next = append(slices.Clip(model), op.value)
+			case len(model) == 0:
+				if op.ok {
+					continue
+				}
+			default:
+				if !op.ok || model[0] != op.value {
+					continue
+				}
+				next = model[1:]
+			}
+			done[i] = true
+			if search(next, left-1) {
+				return true
+			}
+			done[i] = false
+		}
+		return false
+	}
+	return search(nil, len(history))
+}
+
+func TestLinearizableChecker(t *testing.T) {
+	// dequeue returned 2 before 1, but enqueue of 1 finished before enqueue of 2
+	history := []operation{
+		{kind: opEnqueue, value: 1, call: 1, ret: 2},
+		{kind: opEnqueue, value: 2, call: 3, ret: 4},
+		{kind: opDequeue, value: 2, ok: true, call: 5, ret: 6},
+	}
+	if linearizable(history) {
+		t.Errorf("failed: wrong fifo history is linearizable")
+	}
+	// overlapping enqueues may be ordered in both ways
+	history[1].call = 1
+	if !linearizable(history) {
+		t.Errorf("failed: concurrent enqueues must be linearizable")
+	}
+}
+
+func TestQueueLinearizable(t *testing.T) {
+	const (
+		goroutines = 3
+		perG       = 4
+	)
+	for round := range 300 {
+		q := New[int]()
+		ops := make([][]operation, goroutines)
+		for g := range ops {
+			for i := range perG {
+				// unique values, so a dequeue result points one enqueue
+				kind := opKind((g + i + round) % 2)
+				ops[g] = append(ops[g], operation{kind: kind, value: g*perG + i + 1})
+			}
+		}
+
+		history := runHistory(q, ops)
+		if !linearizable(history) {
+			t.Fatalf("failed: history is not linearizable, round %d: %+v", round, history)
+		}
+	}
+}
+
+func TestQueueStress(t *testing.T) {
+	const (
+		producers = 8
+		consumers = 8
+		perG      = 2000
+	)
+	q := New[int]()
+	var wg sync.WaitGroup
+	dequeued := make([][]int, consumers)
+
+	for p := range producers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range perG {
+				q.Enqueue(p*perG + i)
+			}
+		}()
+	}
+	for c := range consumers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range perG {
+				if value, ok := q.Dequeue(); ok {
+					dequeued[c] = append(dequeued[c], value)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for value, ok := q.Dequeue(); ok; value, ok = q.Dequeue() {
+		dequeued[0] = append(dequeued[0], value)
+	}
+
+	// one consumer sees values of one producer in order of enqueue,
+	// and all values are dequeued exactly once
+	seen := make([]bool, producers*perG)
+	for c := range dequeued {
+		last := make([]int, producers)
+		for p := range last {
+			last[p] = -1
+		}
+		for _, value := range dequeued[c] {
+			if seen[value] {
+				t.Fatalf("failed: value %d is dequeued twice", value)
+			}
+			seen[value] = true
+			p := value / perG
+			if value < last[p] {
+				t.Fatalf("failed: fifo order of producer %d is broken: %d after %d", p, value, last[p])
+			}
+			last[p] = value
+		}
+	}
+	for value, ok := range seen {
+		if !ok {
+			t.Fatalf("failed: value %d is lost", value)
+		}
+	}
+	if q.Len() != 0 {
+		t.Errorf("failed: len after stress, got %d", q.Len())
+	}
+}
@@ -1,65 +1,36 @@
 package linkedlist
 
 import (
-	"errors"
+	"fmt"
+	"iter"
 	_ "os"
 	_ "reflect"
+	"slices"
 )
 
-type Node struct {
-	next  *Node
-	value int
-}
+type Node = ListNode[int]
 
+// LinkedList is the int version of List,
+// kept for the existing api
 type LinkedList struct {
-	head *Node
-	tail *Node
-}
-
-func (l *LinkedList) AddInTail(item Node) {
-	if l.head == nil {
-		l.head = &item
-	} else {
-		l.tail.next = &item
-	}
-	l.tail = &item
+	List[int]
 }
 
-// task 5
-// t = O(n), where n = len(list)
-func (l *LinkedList) Count() int {
-	var count int
-	tempNode := l.head
-	for tempNode != nil {
-		count++
-		tempNode = tempNode.next
+func equalInt(n int) func(int) bool {
+	return func(value int) bool {
+		return value == n
 	}
-	return count
 }
 
-func (l *LinkedList) Find(n int) (Node, error) {
-	tempNode := l.head
-	for tempNode != nil {
-		if tempNode.value == n {
-			return *tempNode, nil
-		}
-		tempNode = tempNode.next
-	}
-	return Node{value: -1, next: nil}, errors.New("node is not finding")
+// returns nil node and ErrNotFound on miss
+func (l *LinkedList) Find(n int) (*Node, error) {
+	return l.FindFunc(equalInt(n))
 }
 
 // task 4
 // t = O(n), where n = len(list)
-func (l *LinkedList) FindAll(n int) []Node {
-	var nodes []Node
-	tempNode := l.head
-	for tempNode != nil {
-		if tempNode.value == n {
-			nodes = append(nodes, *tempNode)
-		}
-		tempNode = tempNode.next
-	}
-	return nodes
+func (l *LinkedList) FindAll(n int) []*Node {
+	return l.FindAllFunc(equalInt(n))
 }
 
 // task 1
@@ -67,92 +38,35 @@ func (l *LinkedList) FindAll(n int) []Node {
 // task 2
 // t = O(n), where n = len(list)
 func (l *LinkedList) Delete(n int, all bool) {
-	if l.head == nil {
-		return
-	}
-
-	tempNode := l.head
-	var prev *Node
-
-	if l.Count() == 1 && tempNode.value == n {
-		l.Clean()
-		return
-	}
-
-	for tempNode != nil {
-		deleted := false
-		if tempNode.value == n && tempNode == l.head {
-			l.head = tempNode.next
-			deleted = true
-		} else if tempNode.value == n && tempNode == l.tail {
-			prev.next = nil
-			l.tail = prev
-			deleted = true
-		} else if tempNode.value == n {
-			prev.next = tempNode.next
-			deleted = true
-		}
-		if !all && deleted {
-			return
-		}
-		if !deleted {
-			prev = tempNode
-		}
-		tempNode = tempNode.next
-	}
+	l.DeleteFunc(equalInt(n), all)
 }
 
 // task 6
 // t = O(n), where n = len(list)
-func (l *LinkedList) Insert(after *Node, add Node) {
+func (l *LinkedList) Insert(after *Node, add Node) (*Node, error) {
 	if l.head == nil {
-		l.InsertFirst(add)
-		return
-	}
-	tempNode := l.head
-	// if node will not exists, then we have to finding it first
-	for tempNode.value != after.value {
-		tempNode = tempNode.next
+		return l.InsertFirst(add), nil
 	}
-	if tempNode == l.tail {
-		l.AddInTail(add)
-	} else {
-		nextNode := tempNode.next
-		tempNode.next = &add
-		add.next = nextNode
+	if after == nil || !after.builtByHand() {
+		return l.InsertAfter(after, add)
 	}
-
+	return l.InsertFunc(equalInt(after.value), add)
 }
 
-func (l *LinkedList) InsertFirst(first Node) {
-	if l.head == nil {
-		l.tail = &first
-	} else {
-		first.next = l.head
-	}
-	l.head = &first
-
+// PrintLL prints list with head and tail marks, it is safe for broken lists
+func PrintLL(LL *LinkedList) {
+	fmt.Printf("%+v\n", LL)
 }
 
-// task 3
-// t = O(1)
-func (l *LinkedList) Clean() {
-	l.head = nil
-	l.tail = nil
+func GetLinkedList(values []int) *LinkedList {
+	return Collect(slices.Values(values))
 }
 
-// func PrintLL(LL *LinkedList) {
-// 	temp := LL.head
-// 	for temp != nil {
-// 		fmt.Printf("%d ", temp.value)
-// 		temp = temp.next
-// 	}
-// 	fmt.Println()
-// }
-
-func GetLinkedList(values []int) *LinkedList {
+// Collect builds int linked list from iterator,
+// so for v := range list.All() and slices.Collect work in both directions
+func Collect(seq iter.Seq[int]) *LinkedList {
 	var resultLL LinkedList // resulting linked list
-	for _, value := range values {
+	for value := range seq {
 		resultLL.AddInTail(Node{
 			value: value,
 		})
@@ -160,33 +74,7 @@ func GetLinkedList(values []int) *LinkedList {
 	return &resultLL
 }
 
+// kept for the old api, see Equal, Compare and Diff
 func EqualLists(l1 *LinkedList, l2 *LinkedList) bool {
-	// equals len and elements
-	if l1.head == nil &&
-		l2.head == nil {
-		return true
-	}
-
-	if l1.head.value != l2.head.value {
-		return false
-	}
-	if l1.tail.value != l2.tail.value {
-		return false
-	}
-
-	countL1, countL2 := l1.Count(), l2.Count()
-	if countL1 == countL2 {
-		tempL1, tempL2 := l1.head, l2.head
-		for tempL1 != nil && tempL2 != nil {
-			if tempL1.value != tempL2.value {
-				return false
-			}
-			tempL1 = tempL1.next
-			tempL2 = tempL2.next
-		}
-
-		return true
-	}
-
-	return false
+	return Equal(l1, l2)
 }
@@ -2,6 +2,8 @@ package linkedlist
 
 import (
 	"errors"
+	"maps"
+	"slices"
 	"testing"
 )
 
@@ -35,8 +37,8 @@ func TestDeleted(t *testing.T) {
 			test.input.Delete(value, test.all)
 		}
 
-		if !EqualLists(test.input, test.want) {
-			t.Errorf("failed %s: deleting node with values %v", test.name, test.values)
+		if diff := Diff(test.input, test.want); diff.Index >= 0 {
+			t.Errorf("failed %s: deleting node with values %v, %v", test.name, test.values, diff)
 		}
 	}
 }
@@ -54,8 +56,8 @@ func TestClean(t *testing.T) {
 
 	for _, tempTest := range tests {
 		tempTest.input.Clean()
-		if !EqualLists(tempTest.input, tempTest.want) {
-			t.Errorf("failed %s: clean list", tempTest.name)
+		if diff := Diff(tempTest.input, tempTest.want); diff.Index >= 0 {
+			t.Errorf("failed %s: clean list, %v", tempTest.name, diff)
 		}
 	}
 }
@@ -84,21 +86,24 @@ func TestFind(t *testing.T) {
 		name     string
 		input    *LinkedList
 		findNode Node
-		want     Node
+		want     *Node
 		err      error
 	}{
-		{"Test1: ", GetLinkedList([]int{}), Node{value: 1}, Node{value: -1}, errors.New("node is not finding")},
-		{"Test2: ", GetLinkedList([]int{22, 1, 4, 5, 33}), Node{value: 1}, Node{value: 1}, nil},
-		{"Test3: ", GetLinkedList([]int{22, 1, 4, 5, 33}), Node{value: 10}, Node{value: -1}, errors.New("node is not finding")},
-		{"Test4: ", GetLinkedList([]int{22}), Node{value: 1}, Node{value: -1}, errors.New("node is not finding")},
-		{"Test5: ", GetLinkedList([]int{22}), Node{value: 22}, Node{value: 22}, nil},
+		{"Test1: ", GetLinkedList([]int{}), Node{value: 1}, nil, ErrNotFound},
+		{"Test2: ", GetLinkedList([]int{22, 1, 4, 5, 33}), Node{value: 1}, &Node{value: 1}, nil},
+		{"Test3: ", GetLinkedList([]int{22, 1, 4, 5, 33}), Node{value: 10}, nil, ErrNotFound},
+		{"Test4: ", GetLinkedList([]int{22}), Node{value: 1}, nil, ErrNotFound},
+		{"Test5: ", GetLinkedList([]int{22}), Node{value: 22}, &Node{value: 22}, nil},
 	}
 
 	for _, tempTest := range tests {
 		tempNode, errFind := tempTest.input.Find(tempTest.findNode.value)
-		if tempNode.value != tempTest.want.value && errFind != tempTest.err {
-			t.Errorf("failed %s: find value: %v", tempTest.name, tempTest.findNode)
-
+		if !errors.Is(errFind, tempTest.err) {
+			t.Errorf("failed %s: find value: %v, err %v", tempTest.name, tempTest.findNode, errFind)
+		}
+		if (tempNode == nil) != (tempTest.want == nil) ||
+			tempNode != nil && tempNode.value != tempTest.want.value {
+			t.Errorf("failed %s: find value: %v, got %v", tempTest.name, tempTest.findNode, tempNode)
 		}
 	}
 }
@@ -131,12 +136,13 @@ func TestInsertAfter(t *testing.T) {
 		{"Test1: ", GetLinkedList([]int{}), &Node{value: 1}, Node{value: 5}, GetLinkedList([]int{5})},
 		{"Test2: ", GetLinkedList([]int{22, 3, 2, 45, 6}), &Node{value: 3}, Node{value: 5}, GetLinkedList([]int{22, 3, 5, 2, 45, 6})},
 		{"Test3: ", GetLinkedList([]int{22}), &Node{value: 22}, Node{value: 5}, GetLinkedList([]int{22, 5})},
+		{"Test4: ", GetLinkedList([]int{}), nil, Node{value: 5}, GetLinkedList([]int{5})},
 	}
 
 	for _, tempTest := range tests {
 		tempTest.input.Insert(tempTest.afterNode, tempTest.insertValue)
-		if !EqualLists(tempTest.input, tempTest.want) {
-			t.Errorf("failed %s: insert after value: %v", tempTest.name, tempTest.insertValue)
+		if diff := Diff(tempTest.input, tempTest.want); diff.Index >= 0 {
+			t.Errorf("failed %s: insert after value: %v, %v", tempTest.name, tempTest.insertValue, diff)
 		}
 	}
 }
@@ -155,8 +161,8 @@ func TestInsertFirst(t *testing.T) {
 
 	for _, tempTest := range tests {
 		tempTest.input.InsertFirst(tempTest.insertValue)
-		if !EqualLists(tempTest.input, tempTest.want) {
-			t.Errorf("failed %s: insert first value: %v", tempTest.name, tempTest.insertValue)
+		if diff := Diff(tempTest.input, tempTest.want); diff.Index >= 0 {
+			t.Errorf("failed %s: insert first value: %v, %v", tempTest.name, tempTest.insertValue, diff)
 		}
 	}
 }
@@ -174,8 +180,43 @@ func TestAddInTail(t *testing.T) {
 
 	for _, tempTest := range tests {
 		tempTest.input.AddInTail(tempTest.insertValue)
-		if !EqualLists(tempTest.input, tempTest.want) {
-			t.Errorf("failed %s: add in tail value: %v", tempTest.name, tempTest.insertValue)
+		if diff := Diff(tempTest.input, tempTest.want); diff.Index >= 0 {
+			t.Errorf("failed %s: add in tail value: %v, %v", tempTest.name, tempTest.insertValue, diff)
+		}
+	}
+}
+
+func TestIterators(t *testing.T) {
+	list := Collect(slices.Values([]int{22, 3, 2, 45, 6}))
+
+	if got := slices.Collect(list.All()); !slices.Equal(got, []int{22, 3, 2, 45, 6}) {
+		t.Errorf("failed: all values, got %v", got)
+	}
+	if got := slices.Sorted(list.Values()); !slices.Equal(got, []int{2, 3, 6, 22, 45}) {
+		t.Errorf("failed: sorted values, got %v", got)
+	}
+
+	positions := maps.Collect(list.Enumerate())
+	if len(positions) != 5 || positions[0] != 22 || positions[4] != 6 {
+		t.Errorf("failed: enumerate, got %v", positions)
+	}
+
+	// early break must stop iteration
+	var first []int
+	for value := range list.All() {
+		if value == 2 {
+			break
 		}
+		first = append(first, value)
+	}
+	if !slices.Equal(first, []int{22, 3}) {
+		t.Errorf("failed: break in range, got %v", first)
+	}
+
+	if !EqualLists(Collect(list.All()), GetLinkedList([]int{22, 3, 2, 45, 6})) {
+		t.Errorf("failed: collect from list iterator")
+	}
+	if got := slices.Collect(Collect(slices.Values([]int{})).All()); len(got) != 0 {
+		t.Errorf("failed: collect empty, got %v", got)
 	}
 }
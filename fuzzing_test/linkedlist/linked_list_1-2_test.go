@@ -15,16 +15,28 @@ func TestAdditionLL(t *testing.T) {
 	}{
 		{"Test1: ", GetLinkedList([]int{}), GetLinkedList([]int{}), nil, GetLinkedList([]int{})},
 		{"Test2: ", GetLinkedList([]int{22, 3, 2, 45, 6}), GetLinkedList([]int{10, 11, 1, 2, 3}), nil, GetLinkedList([]int{32, 14, 3, 47, 9})},
-		{"Test3: ", GetLinkedList([]int{22, 3, 2, 45, 6}), GetLinkedList([]int{10, 11}), errors.New("different lengths"), GetLinkedList([]int{})},
+		{"Test3: ", GetLinkedList([]int{22, 3, 2, 45, 6}), GetLinkedList([]int{10, 11}), ErrLengthMismatch, GetLinkedList([]int{})},
 		{"Test4: ", GetLinkedList([]int{22}), GetLinkedList([]int{10}), nil, GetLinkedList([]int{32})},
-		{"Test5: ", GetLinkedList([]int{22}), GetLinkedList([]int{10, 11}), errors.New("different lengths"), GetLinkedList([]int{})},
+		{"Test5: ", GetLinkedList([]int{22}), GetLinkedList([]int{10, 11}), ErrLengthMismatch, GetLinkedList([]int{})},
 	}
 
 	for _, tempTest := range tests {
 		test := tempTest
 		resultL, err := GetAdditionalLists(test.inputL1, test.inputL2)
-		if !EqualLists(resultL, test.wantL3) && err != test.err {
-			t.Errorf("failed %s: additional lists", test.name)
+		if diff := Diff(resultL, test.wantL3); diff.Index >= 0 {
+			t.Errorf("failed %s: additional lists, %v", test.name, diff)
 		}
+		if !errors.Is(err, test.err) {
+			t.Errorf("failed %s: additional lists, err %v", test.name, err)
+		}
+	}
+}
+
+func TestAdditionLLMismatchLengths(t *testing.T) {
+	_, err := GetAdditionalLists(GetLinkedList([]int{22, 3, 2}), GetLinkedList([]int{10}))
+
+	var mismatch *LengthMismatchError
+	if !errors.As(err, &mismatch) || mismatch.Len1 != 3 || mismatch.Len2 != 1 {
+		t.Errorf("failed: length mismatch error, got %v", err)
 	}
 }
@@ -0,0 +1,259 @@
+package linkedlist
+
+import (
+	"cmp"
+)
+
+// algorithms relink existing nodes, so handles stay valid
+// for nodes which are kept in the list
+
+// t = O(n), where n = len(list)
+func (l *List[T]) Reverse() {
+	var prev *ListNode[T]
+	tempNode := l.head
+	for tempNode != nil {
+		next := tempNode.next
+		tempNode.next = prev
+		prev = tempNode
+		tempNode = next
+	}
+	l.head, l.tail = l.tail, l.head
+}
+
+// SortFunc is a stable bottom-up merge sort, cmp is in style of cmp.Compare,
+// t = O(n*log(n)), mem = O(1), where n = len(list)
+func (l *List[T]) SortFunc(cmp func(a, b T) int) {
+	for width := 1; width < l.length; width *= 2 {
+		var sortedHead, sortedTail *ListNode[T]
+		tempNode := l.head
+		for tempNode != nil {
+			left := tempNode
+			right := cutAfter(left, width)
+			tempNode = cutAfter(right, width)
+
+			head, tail := mergeNodes(left, right, cmp)
+			if sortedTail == nil {
+				sortedHead = head
+			} else {
+				sortedTail.next = head
+			}
+			sortedTail = tail
+		}
+		l.head, l.tail = sortedHead, sortedTail
+	}
+}
+
+// cuts chain after count nodes and returns the rest of it
+func cutAfter[T any](node *ListNode[T], count int) *ListNode[T] {
+	for ; node != nil && count > 1; count-- {
+		node = node.next
+	}
+	if node == nil {
+		return nil
+	}
+	rest := node.next
+	node.next = nil
+	return rest
+}
+
+// merges two sorted chains, equal values are taken from left first
+func mergeNodes[T any](left, right *ListNode[T], cmp func(a, b T) int) (*ListNode[T], *ListNode[T]) {
+	var dummy ListNode[T]
+	tail := &dummy
+	for left != nil && right != nil {
+		if cmp(left.value, right.value) <= 0 {
+			tail.next, left = left, left.next
+		} else {
+			tail.next, right = right, right.next
+		}
+		tail = tail.next
+	}
+	if left != nil {
+		tail.next = left
+	} else {
+		tail.next = right
+	}
+	for tail.next != nil {
+		tail = tail.next
+	}
+	return dummy.next, tail
+}
+
+// SortedInsertFunc inserts add after the last node which is not greater,
+// so sorted list stays sorted and stable
+// t = O(n), where n = len(list)
+func (l *List[T]) SortedInsertFunc(add ListNode[T], cmp func(a, b T) int) *ListNode[T] {
+	var after *ListNode[T]
+	for tempNode := l.head; tempNode != nil && cmp(tempNode.value, add.value) <= 0; tempNode = tempNode.next {
+		after = tempNode
+	}
+	if after == nil {
+		return l.InsertFirst(add)
+	}
+	node, _ := l.InsertAfter(after, add)
+	return node
+}
+
+// MergeSortedFunc returns new sorted list with values of both sorted lists,
+// l1 and l2 are not changed
+// t = O(n+m), where n = len(l1), m = len(l2)
+func MergeSortedFunc[T any](l1 *List[T], l2 *List[T], cmp func(a, b T) int) *List[T] {
+	resultL := NewListFunc(l1.equal)
+	mergeSortedInto(resultL, l1, l2, cmp)
+	return resultL
+}
+
+func mergeSortedInto[T any](resultL *List[T], l1 *List[T], l2 *List[T], cmp func(a, b T) int) {
+	tempNodeL1, tempNodeL2 := l1.head, l2.head
+	for tempNodeL1 != nil || tempNodeL2 != nil {
+		if tempNodeL2 == nil || tempNodeL1 != nil && cmp(tempNodeL1.value, tempNodeL2.value) <= 0 {
+			resultL.AddInTail(ListNode[T]{value: tempNodeL1.value})
+			tempNodeL1 = tempNodeL1.next
+		} else {
+			resultL.AddInTail(ListNode[T]{value: tempNodeL2.value})
+			tempNodeL2 = tempNodeL2.next
+		}
+	}
+}
+
+// DedupeSorted keeps the first node of every run of equal values
+// t = O(n), where n = len(list)
+func (l *List[T]) DedupeSorted() {
+	for tempNode := l.head; tempNode != nil; tempNode = tempNode.next {
+		same := l.matches(tempNode.value)
+		for tempNode.next != nil && same(tempNode.next.value) {
+			l.removeNext(tempNode)
+		}
+	}
+}
+
+// removes node after prev, prev is nil for head
+func (l *List[T]) removeNext(prev *ListNode[T]) {
+	node := l.head
+	if prev == nil {
+		l.head = node.next
+	} else {
+		node = prev.next
+		prev.next = node.next
+	}
+	if node == l.tail {
+		l.tail = prev
+	}
+	l.release(node)
+	l.length--
+}
+
+// PartitionFunc moves nodes matching pred before the others,
+// order inside both parts is kept
+// t = O(n), where n = len(list)
+func (l *List[T]) PartitionFunc(pred func(T) bool) {
+	var before, after ListNode[T] // dummy heads of both parts
+	beforeTail, afterTail := &before, &after
+	for tempNode := l.head; tempNode != nil; tempNode = tempNode.next {
+		if pred(tempNode.value) {
+			beforeTail.next, beforeTail = tempNode, tempNode
+		} else {
+			afterTail.next, afterTail = tempNode, tempNode
+		}
+	}
+	beforeTail.next = after.next
+	afterTail.next = nil
+	if l.head == nil {
+		return
+	}
+	l.head = before.next
+	if afterTail != &after {
+		l.tail = afterTail
+	} else {
+		l.tail = beforeTail
+	}
+}
+
+// RotateLeft moves k first nodes to the tail, negative k rotates right
+// t = O(n), where n = len(list)
+func (l *List[T]) RotateLeft(k int) {
+	if l.length == 0 {
+		return
+	}
+	k = (k%l.length + l.length) % l.length
+	if k == 0 {
+		return
+	}
+	newTail := l.head
+	for range k - 1 {
+		newTail = newTail.next
+	}
+	l.tail.next = l.head
+	l.head = newTail.next
+	newTail.next = nil
+	l.tail = newTail
+}
+
+// HasCycle is Floyd's tortoise and hare, it does not trust length,
+// so it finds cycles in broken lists too
+// t = O(n), mem = O(1), where n = len(list)
+func (l *List[T]) HasCycle() bool {
+	slow, fast := l.head, l.head
+	for fast != nil && fast.next != nil {
+		slow, fast = slow.next, fast.next.next
+		if slow == fast {
+			return true
+		}
+	}
+	return false
+}
+
+// Middle returns node at len/2, for even length it is the second of two middles,
+// nil for empty list
+// t = O(n), where n = len(list)
+func (l *List[T]) Middle() *ListNode[T] {
+	slow, fast := l.head, l.head
+	for fast != nil && fast.next != nil {
+		slow, fast = slow.next, fast.next.next
+	}
+	return slow
+}
+
+// int versions of LinkedList
+
+// t = O(n*log(n)), mem = O(1), where n = len(list)
+func (l *LinkedList) Sort() {
+	l.SortFunc(cmp.Compare[int])
+}
+
+// t = O(n), where n = len(list)
+func (l *LinkedList) SortedInsert(n int) *Node {
+	return l.SortedInsertFunc(Node{value: n}, cmp.Compare[int])
+}
+
+// t = O(n+m), where n = len(l1), m = len(l2)
+func MergeSorted(l1 *LinkedList, l2 *LinkedList) *LinkedList {
+	var resultLL LinkedList // resulting linked list
+	mergeSortedInto(&resultLL.List, &l1.List, &l2.List, cmp.Compare[int])
+	return &resultLL
+}
+
+// Dedupe keeps the first node of every value
+// t = O(n), mem = O(n), where n = len(list)
+func (l *LinkedList) Dedupe() {
+	seen := make(map[int]struct{}, l.Count())
+	var prev *Node
+	for tempNode := l.head; tempNode != nil; {
+		next := tempNode.next
+		if _, ok := seen[tempNode.value]; ok {
+			l.removeNext(prev)
+		} else {
+			seen[tempNode.value] = struct{}{}
+			prev = tempNode
+		}
+		tempNode = next
+	}
+}
+
+// Partition moves values less than pivot before the others, stable
+// t = O(n), where n = len(list)
+func (l *LinkedList) Partition(pivot int) {
+	l.PartitionFunc(func(value int) bool {
+		return value < pivot
+	})
+}
@@ -0,0 +1,227 @@
+package linkedlist
+
+import (
+	"slices"
+	"testing"
+)
+
+// checks list against slice model after algorithm
+func checkListModel(t *testing.T, l *LinkedList, want []int, op string) {
+	t.Helper()
+	mustValidate(t, l, op)
+	if got := listToSlice(l, len(want)+1); !slices.Equal(got, want) {
+		t.Fatalf("%s mismatch: got=%v want=%v", op, got, want)
+	}
+}
+
+func FuzzLinkedList_Reverse(f *testing.F) {
+	f.Add(intsToBytes([]int{}))
+	f.Add(intsToBytes([]int{1}))
+	f.Add(intsToBytes([]int{1, 2, 3}))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if len(data) > 3000 {
+			t.Skip()
+		}
+		ints := bytesToInts(data, 300)
+		list := GetLinkedList(ints)
+
+		list.Reverse()
+		want := slices.Clone(ints)
+		slices.Reverse(want)
+		checkListModel(t, list, want, "Reverse")
+	})
+}
+
+func FuzzLinkedList_Sort(f *testing.F) {
+	f.Add(intsToBytes([]int{}))
+	f.Add(intsToBytes([]int{1}))
+	f.Add(intsToBytes([]int{3, 1, 2, 3, 0, -1}))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if len(data) > 3000 {
+			t.Skip()
+		}
+		ints := bytesToInts(data, 300)
+
+		// sort pairs of value and position by value to check stability
+		type pair struct{ value, pos int }
+		list := NewListFunc(func(a, b pair) bool { return a == b })
+		model := make([]pair, 0, len(ints))
+		for pos, v := range ints {
+			list.AddInTail(NewListNode(pair{v % 8, pos}))
+			model = append(model, pair{v % 8, pos})
+		}
+		byValue := func(a, b pair) int { return a.value - b.value }
+
+		list.SortFunc(byValue)
+		slices.SortStableFunc(model, byValue)
+		if err := list.Validate(); err != nil {
+			t.Fatalf("after Sort: %v", err)
+		}
+		if got := genericToSlice(list); !slices.Equal(got, model) {
+			t.Fatalf("Sort mismatch: got=%v want=%v", got, model)
+		}
+	})
+}
+
+func FuzzLinkedList_SortedInsert(f *testing.F) {
+	f.Add(intsToBytes([]int{}), 1)
+	f.Add(intsToBytes([]int{1, 3, 5}), 3)
+	f.Add(intsToBytes([]int{1, 3, 5}), -1)
+
+	f.Fuzz(func(t *testing.T, data []byte, value int) {
+		if len(data) > 3000 {
+			t.Skip()
+		}
+		ints := bytesToInts(data, 300)
+		slices.Sort(ints)
+		list := GetLinkedList(ints)
+
+		node := list.SortedInsert(value)
+		if node.value != value {
+			t.Fatalf("SortedInsert returned wrong node: got=%d want=%d", node.value, value)
+		}
+		pos, _ := slices.BinarySearch(ints, value)
+		for pos < len(ints) && ints[pos] == value {
+			pos++
+		}
+		checkListModel(t, list, slices.Insert(slices.Clone(ints), pos, value), "SortedInsert")
+	})
+}
+
+func FuzzMergeSorted(f *testing.F) {
+	f.Add(intsToBytes([]int{}), intsToBytes([]int{}))
+	f.Add(intsToBytes([]int{1, 4}), intsToBytes([]int{0, 4, 9}))
+
+	f.Fuzz(func(t *testing.T, data1 []byte, data2 []byte) {
+		if len(data1) > 3000 || len(data2) > 3000 {
+			t.Skip()
+		}
+		ints1, ints2 := bytesToInts(data1, 200), bytesToInts(data2, 200)
+		slices.Sort(ints1)
+		slices.Sort(ints2)
+
+		merged := MergeSorted(GetLinkedList(ints1), GetLinkedList(ints2))
+		want := slices.Sorted(slices.Values(append(slices.Clone(ints1), ints2...)))
+		checkListModel(t, merged, want, "MergeSorted")
+	})
+}
+
+func FuzzLinkedList_Dedupe(f *testing.F) {
+	f.Add(intsToBytes([]int{}), false)
+	f.Add(intsToBytes([]int{1, 1, 2, 1}), false)
+	f.Add(intsToBytes([]int{3, 1, 3, 2}), true)
+
+	f.Fuzz(func(t *testing.T, data []byte, sorted bool) {
+		if len(data) > 3000 {
+			t.Skip()
+		}
+		ints := bytesToInts(data, 300)
+		if sorted {
+			slices.Sort(ints)
+		}
+		list := GetLinkedList(ints)
+
+		want := make([]int, 0, len(ints))
+		for _, v := range ints {
+			if !slices.Contains(want, v) {
+				want = append(want, v)
+			}
+		}
+		if sorted {
+			list.DedupeSorted()
+			checkListModel(t, list, want, "DedupeSorted")
+		} else {
+			list.Dedupe()
+			checkListModel(t, list, want, "Dedupe")
+		}
+	})
+}
+
+func FuzzLinkedList_Partition(f *testing.F) {
+	f.Add(intsToBytes([]int{}), 0)
+	f.Add(intsToBytes([]int{5, 1, 7, 2}), 5)
+	f.Add(intsToBytes([]int{5, 6}), 1)
+
+	f.Fuzz(func(t *testing.T, data []byte, pivot int) {
+		if len(data) > 3000 {
+			t.Skip()
+		}
+		ints := bytesToInts(data, 300)
+		list := GetLinkedList(ints)
+
+		list.Partition(pivot)
+		want := make([]int, 0, len(ints))
+		for _, v := range ints {
+			if v < pivot {
+				want = append(want, v)
+			}
+		}
+		for _, v := range ints {
+			if v >= pivot {
+				want = append(want, v)
+			}
+		}
+		checkListModel(t, list, want, "Partition")
+	})
+}
+
+func FuzzLinkedList_RotateLeft(f *testing.F) {
+	f.Add(intsToBytes([]int{}), 1)
+	f.Add(intsToBytes([]int{1, 2, 3}), 1)
+	f.Add(intsToBytes([]int{1, 2, 3}), -4)
+
+	f.Fuzz(func(t *testing.T, data []byte, k int) {
+		if len(data) > 3000 {
+			t.Skip()
+		}
+		ints := bytesToInts(data, 300)
+		list := GetLinkedList(ints)
+
+		list.RotateLeft(k)
+		want := slices.Clone(ints)
+		if n := len(want); n > 0 {
+			shift := (k%n + n) % n
+			want = append(want[shift:], want[:shift]...)
+		}
+		checkListModel(t, list, want, "RotateLeft")
+	})
+}
+
+func FuzzLinkedList_CycleAndMiddle(f *testing.F) {
+	f.Add(intsToBytes([]int{}), 0)
+	f.Add(intsToBytes([]int{1, 2, 3}), 1)
+	f.Add(intsToBytes([]int{1, 2, 3, 4}), -1)
+
+	f.Fuzz(func(t *testing.T, data []byte, cycleTo int) {
+		if len(data) > 3000 {
+			t.Skip()
+		}
+		ints := bytesToInts(data, 300)
+		list := GetLinkedList(ints)
+
+		middle := list.Middle()
+		if len(ints) == 0 {
+			if middle != nil {
+				t.Fatalf("Middle of empty list must be nil")
+			}
+			return
+		}
+		nodes := list.FindAllFunc(func(int) bool { return true })
+		if middle != nodes[len(ints)/2] {
+			t.Fatalf("Middle mismatch: got=%d want index %d of %v", middle.value, len(ints)/2, ints)
+		}
+		if list.HasCycle() {
+			t.Fatalf("HasCycle found cycle in valid list %v", ints)
+		}
+
+		// negative cycleTo keeps list without cycle
+		if cycleTo >= 0 {
+			list.tail.next = nodes[cycleTo%len(nodes)]
+			if !list.HasCycle() {
+				t.Fatalf("HasCycle missed cycle to %d in %v", cycleTo%len(nodes), ints)
+			}
+		}
+	})
+}
@@ -0,0 +1,136 @@
+package linkedlist
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+func TestReverse(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		want  []int
+	}{
+		{"Test1: ", []int{}, []int{}},
+		{"Test2: ", []int{1}, []int{1}},
+		{"Test3: ", []int{22, 3, 2, 45}, []int{45, 2, 3, 22}},
+	}
+
+	for _, test := range tests {
+		list := GetLinkedList(test.input)
+		list.Reverse()
+		if !EqualLists(list, GetLinkedList(test.want)) || list.Validate() != nil {
+			t.Errorf("failed %s: reverse, got %v", test.name, slices.Collect(list.All()))
+		}
+	}
+}
+
+func TestSort(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		want  []int
+	}{
+		{"Test1: ", []int{}, []int{}},
+		{"Test2: ", []int{1}, []int{1}},
+		{"Test3: ", []int{22, 3, 2, 45, 6, 3}, []int{2, 3, 3, 6, 22, 45}},
+		{"Test4: ", []int{5, 4, 3, 2, 1}, []int{1, 2, 3, 4, 5}},
+	}
+
+	for _, test := range tests {
+		list := GetLinkedList(test.input)
+		list.Sort()
+		if !EqualLists(list, GetLinkedList(test.want)) || list.Validate() != nil {
+			t.Errorf("failed %s: sort, got %v", test.name, slices.Collect(list.All()))
+		}
+	}
+
+	// equal keys keep their order
+	list := GetList([]user{{2, "a"}, {1, "b"}, {2, "c"}, {1, "d"}})
+	list.SortFunc(func(a, b user) int { return cmp.Compare(a.id, b.id) })
+	if got, want := genericToSlice(list), []user{{1, "b"}, {1, "d"}, {2, "a"}, {2, "c"}}; !slices.Equal(got, want) {
+		t.Errorf("failed: stable sort, got %v", got)
+	}
+}
+
+func TestSortedInsertAndMerge(t *testing.T) {
+	list := GetLinkedList([]int{1, 3, 5})
+	for _, value := range []int{0, 3, 6, 4} {
+		list.SortedInsert(value)
+	}
+	if !EqualLists(list, GetLinkedList([]int{0, 1, 3, 3, 4, 5, 6})) || list.Validate() != nil {
+		t.Errorf("failed: sorted insert, got %v", slices.Collect(list.All()))
+	}
+
+	l1, l2 := GetLinkedList([]int{1, 4, 4}), GetLinkedList([]int{0, 4, 9})
+	merged := MergeSorted(l1, l2)
+	if !EqualLists(merged, GetLinkedList([]int{0, 1, 4, 4, 4, 9})) || merged.Validate() != nil {
+		t.Errorf("failed: merge sorted, got %v", slices.Collect(merged.All()))
+	}
+	if !EqualLists(l1, GetLinkedList([]int{1, 4, 4})) || !EqualLists(l2, GetLinkedList([]int{0, 4, 9})) {
+		t.Errorf("failed: merge sorted changed input lists")
+	}
+}
+
+func TestDedupe(t *testing.T) {
+	list := GetLinkedList([]int{3, 1, 3, 2, 1, 3})
+	list.Dedupe()
+	if !EqualLists(list, GetLinkedList([]int{3, 1, 2})) || list.Validate() != nil {
+		t.Errorf("failed: dedupe, got %v", slices.Collect(list.All()))
+	}
+
+	list = GetLinkedList([]int{1, 1, 2, 3, 3, 3})
+	list.DedupeSorted()
+	if !EqualLists(list, GetLinkedList([]int{1, 2, 3})) || list.Validate() != nil {
+		t.Errorf("failed: dedupe sorted, got %v", slices.Collect(list.All()))
+	}
+}
+
+func TestPartitionAndRotate(t *testing.T) {
+	list := GetLinkedList([]int{5, 1, 7, 2, 5, 0})
+	list.Partition(5)
+	if !EqualLists(list, GetLinkedList([]int{1, 2, 0, 5, 7, 5})) || list.Validate() != nil {
+		t.Errorf("failed: partition, got %v", slices.Collect(list.All()))
+	}
+
+	tests := []struct {
+		name string
+		k    int
+		want []int
+	}{
+		{"Test1: ", 0, []int{1, 2, 3, 4}},
+		{"Test2: ", 1, []int{2, 3, 4, 1}},
+		{"Test3: ", 6, []int{3, 4, 1, 2}},
+		{"Test4: ", -1, []int{4, 1, 2, 3}},
+	}
+	for _, test := range tests {
+		list := GetLinkedList([]int{1, 2, 3, 4})
+		list.RotateLeft(test.k)
+		if !EqualLists(list, GetLinkedList(test.want)) || list.Validate() != nil {
+			t.Errorf("failed %s: rotate left, got %v", test.name, slices.Collect(list.All()))
+		}
+	}
+}
+
+func TestCycleAndMiddle(t *testing.T) {
+	list := GetLinkedList([]int{1, 2, 3, 4, 5})
+	if list.HasCycle() {
+		t.Errorf("failed: list without cycle")
+	}
+	if middle := list.Middle(); middle.Value() != 3 {
+		t.Errorf("failed: middle, got %d", middle.Value())
+	}
+	list.AddInTail(Node{value: 6})
+	if middle := list.Middle(); middle.Value() != 4 {
+		t.Errorf("failed: middle of even list, got %d", middle.Value())
+	}
+	if GetLinkedList([]int{}).Middle() != nil {
+		t.Errorf("failed: middle of empty list")
+	}
+
+	list.tail.next = list.head.next
+	if !list.HasCycle() {
+		t.Errorf("failed: cycle is not found")
+	}
+}
@@ -0,0 +1,199 @@
+package linkedlist
+
+import (
+	"fmt"
+)
+
+// bulk operations move whole chains of nodes between lists,
+// moved nodes get the new owner, so their handles stay valid in the new list
+// and are rejected by the old one
+
+// Concat moves all nodes of other to the tail of l, other becomes empty,
+// other == l changes nothing
+// t = O(1)
+func (l *List[T]) Concat(other *List[T]) {
+	if other == nil || other == l || other.head == nil {
+		return
+	}
+	l.takeOwner(other)
+	if l.head == nil {
+		l.head = other.head
+	} else {
+		l.tail.next = other.head
+	}
+	l.tail = other.tail
+	l.length += other.length
+	other.head, other.tail, other.length = nil, nil, 0
+}
+
+// SpliceAfter moves all nodes of other after node of l, other becomes empty,
+// other == l changes nothing
+// t = O(1)
+func (l *List[T]) SpliceAfter(node *ListNode[T], other *List[T]) error {
+	if !l.owns(node) {
+		return ErrForeignNode
+	}
+	if other == nil || other == l || other.head == nil {
+		return nil
+	}
+	if node == l.tail {
+		l.Concat(other)
+		return nil
+	}
+	l.takeOwner(other)
+	other.tail.next = node.next
+	node.next = other.head
+	l.length += other.length
+	other.head, other.tail, other.length = nil, nil, 0
+	return nil
+}
+
+// SplitAt cuts list before index, the first list is l itself with
+// nodes [0, index), the second one is new with nodes [index, len),
+// index must be in [0, len]
+// t = O(n), where n = len(list), nodes of the second list get its owner
+func (l *List[T]) SplitAt(index int) (*List[T], *List[T], error) {
+	rest := l.emptyLike()
+	if err := l.splitInto(index, &rest); err != nil {
+		return nil, nil, err
+	}
+	return l, &rest, nil
+}
+
+func (l *List[T]) splitInto(index int, rest *List[T]) error {
+	if index < 0 || index > l.length {
+		return fmt.Errorf("%w: %d not in [0, %d]", ErrOutOfRange, index, l.length)
+	}
+	if index == l.length {
+		return nil
+	}
+	rest.length = l.length - index
+	if index == 0 {
+		// the whole list moves, so its record moves too
+		rest.head, rest.tail, rest.owner = l.head, l.tail, l.owner
+		l.head, l.tail, l.owner, l.length = nil, nil, nil, 0
+		return nil
+	}
+	prev := l.nodeAt(index - 1)
+	rest.head, rest.tail = prev.next, l.tail
+	prev.next = nil
+	l.tail = prev
+	l.length = index
+	rest.adopt(rest.head)
+	return nil
+}
+
+// Slice copies values [from, to) to a new list, l is not changed,
+// bounds are checked as for slices: 0 <= from <= to <= len
+// t = O(to), where to - the end of slice
+func (l *List[T]) Slice(from, to int) (*List[T], error) {
+	resultL := l.emptyLike()
+	if err := l.sliceInto(from, to, &resultL); err != nil {
+		return nil, err
+	}
+	return &resultL, nil
+}
+
+func (l *List[T]) sliceInto(from, to int, resultL *List[T]) error {
+	if from < 0 || to < from || to > l.length {
+		return fmt.Errorf("%w: [%d, %d) not in [0, %d]", ErrOutOfRange, from, to, l.length)
+	}
+	if from == to {
+		return nil
+	}
+	tempNode := l.nodeAt(from)
+	for range to - from {
+		resultL.AddInTail(ListNode[T]{value: tempNode.value})
+		tempNode = tempNode.next
+	}
+	return nil
+}
+
+// RemoveIf removes all nodes matching pred and returns how many were removed
+// t = O(n), where n = len(list)
+func (l *List[T]) RemoveIf(pred func(T) bool) int {
+	removed := 0
+	var prev *ListNode[T]
+	for tempNode := l.head; tempNode != nil; {
+		next := tempNode.next
+		if pred(tempNode.value) {
+			l.removeNext(prev)
+			removed++
+		} else {
+			prev = tempNode
+		}
+		tempNode = next
+	}
+	return removed
+}
+
+// emptyLike is an empty list with the same equal function,
+// pooled list gets its own pool with the same limit
+func (l *List[T]) emptyLike() List[T] {
+	resultL := List[T]{equal: l.equal}
+	if l.pool != nil {
+		resultL.pool = &nodePool[T]{limit: l.pool.limit}
+	}
+	return resultL
+}
+
+// index must be in [0, len)
+func (l *List[T]) nodeAt(index int) *ListNode[T] {
+	tempNode := l.head
+	for range index {
+		tempNode = tempNode.next
+	}
+	return tempNode
+}
+
+// takeOwner links the owner record of other to the record of l,
+// so all nodes of other belong to l, other gets a new record with the next node
+func (l *List[T]) takeOwner(other *List[T]) {
+	other.owner.parent = l.ownerRecord()
+	other.owner = nil
+}
+
+// adopt makes l the owner of the chain from first to the end,
+// it is used for a part of list, which can not be moved by its record
+func (l *List[T]) adopt(first *ListNode[T]) {
+	owner := l.ownerRecord()
+	for tempNode := first; tempNode != nil; tempNode = tempNode.next {
+		tempNode.owner = owner
+	}
+}
+
+// int versions of LinkedList
+
+// t = O(1)
+func (l *LinkedList) Concat(other *LinkedList) {
+	if other == nil {
+		return
+	}
+	l.List.Concat(&other.List)
+}
+
+// t = O(1)
+func (l *LinkedList) SpliceAfter(node *Node, other *LinkedList) error {
+	if other == nil {
+		return l.List.SpliceAfter(node, nil)
+	}
+	return l.List.SpliceAfter(node, &other.List)
+}
+
+// t = O(n), where n = len(list)
+func (l *LinkedList) SplitAt(index int) (*LinkedList, *LinkedList, error) {
+	rest := LinkedList{List: l.emptyLike()}
+	if err := l.splitInto(index, &rest.List); err != nil {
+		return nil, nil, err
+	}
+	return l, &rest, nil
+}
+
+// t = O(to), where to - the end of slice
+func (l *LinkedList) Slice(from, to int) (*LinkedList, error) {
+	resultLL := LinkedList{List: l.emptyLike()}
+	if err := l.sliceInto(from, to, &resultLL.List); err != nil {
+		return nil, err
+	}
+	return &resultLL, nil
+}
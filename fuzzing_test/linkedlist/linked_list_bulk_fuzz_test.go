@@ -0,0 +1,159 @@
+package linkedlist
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// bulk fuzz targets run on pooled lists too, moved nodes must not be
+// released to the pool of the old list
+func bulkList(ints []int, pooled bool) *LinkedList {
+	if !pooled {
+		return GetLinkedList(ints)
+	}
+	list := NewLinkedList(WithNodePool[int](4))
+	for _, v := range ints {
+		list.AddInTail(Node{value: v})
+	}
+	return list
+}
+
+func FuzzLinkedList_Concat(f *testing.F) {
+	f.Add(intsToBytes([]int{}), intsToBytes([]int{}), -1, false)
+	f.Add(intsToBytes([]int{1, 2}), intsToBytes([]int{3}), 0, true)
+	f.Add(intsToBytes([]int{1, 2, 3}), intsToBytes([]int{4, 5}), 1, false)
+
+	f.Fuzz(func(t *testing.T, data1 []byte, data2 []byte, after int, pooled bool) {
+		if len(data1) > 3000 || len(data2) > 3000 {
+			t.Skip()
+		}
+		ints1, ints2 := bytesToInts(data1, 100), bytesToInts(data2, 100)
+		l1, l2 := bulkList(ints1, pooled), bulkList(ints2, pooled)
+		handles := l2.FindAllFunc(func(int) bool { return true })
+
+		// negative after is Concat, otherwise SpliceAfter node at after
+		var want []int
+		if after < 0 || len(ints1) == 0 {
+			l1.Concat(l2)
+			want = slices.Concat(ints1, ints2)
+		} else {
+			after %= len(ints1)
+			if err := l1.SpliceAfter(l1.nodeAt(after), l2); err != nil {
+				t.Fatalf("SpliceAfter(%d): %v", after, err)
+			}
+			want = slices.Concat(ints1[:after+1], ints2, ints1[after+1:])
+		}
+		checkListModel(t, l1, want, "Concat")
+		checkListModel(t, l2, []int{}, "Concat other")
+
+		// handles of moved nodes work in the new list only
+		for _, node := range handles {
+			if err := l2.Remove(node); !errors.Is(err, ErrForeignNode) {
+				t.Fatalf("old list removed moved node: %v", err)
+			}
+			if err := l1.Remove(node); err != nil {
+				t.Fatalf("new list rejected moved node: %v", err)
+			}
+		}
+		checkListModel(t, l1, ints1, "Remove moved")
+
+		l1.AddInTail(Node{value: -1})
+		l2.AddInTail(Node{value: -2})
+		checkListModel(t, l1, slices.Concat(ints1, []int{-1}), "AddInTail after Concat")
+		checkListModel(t, l2, []int{-2}, "AddInTail to other")
+	})
+}
+
+func FuzzLinkedList_SplitAt(f *testing.F) {
+	f.Add(intsToBytes([]int{}), 0, false)
+	f.Add(intsToBytes([]int{1, 2, 3}), 1, true)
+	f.Add(intsToBytes([]int{1, 2, 3}), 4, false)
+
+	f.Fuzz(func(t *testing.T, data []byte, index int, pooled bool) {
+		if len(data) > 3000 {
+			t.Skip()
+		}
+		ints := bytesToInts(data, 300)
+		list := bulkList(ints, pooled)
+
+		front, back, err := list.SplitAt(index)
+		if index < 0 || index > len(ints) {
+			if !errors.Is(err, ErrOutOfRange) {
+				t.Fatalf("SplitAt(%d) of %d values: %v", index, len(ints), err)
+			}
+			checkListModel(t, list, ints, "SplitAt out of range")
+			return
+		}
+		if err != nil {
+			t.Fatalf("SplitAt(%d) of %d values: %v", index, len(ints), err)
+		}
+		checkListModel(t, front, ints[:index], "SplitAt front")
+		checkListModel(t, back, ints[index:], "SplitAt back")
+
+		// both parts are independent lists now
+		front.Clean()
+		back.AddInTail(Node{value: -1})
+		checkListModel(t, back, append(slices.Clone(ints[index:]), -1), "AddInTail to back")
+		front.Concat(back)
+		checkListModel(t, front, append(slices.Clone(ints[index:]), -1), "Concat back")
+	})
+}
+
+func FuzzLinkedList_Slice(f *testing.F) {
+	f.Add(intsToBytes([]int{}), 0, 0)
+	f.Add(intsToBytes([]int{1, 2, 3}), 1, 2)
+	f.Add(intsToBytes([]int{1, 2, 3}), 2, 1)
+
+	f.Fuzz(func(t *testing.T, data []byte, from int, to int) {
+		if len(data) > 3000 {
+			t.Skip()
+		}
+		ints := bytesToInts(data, 300)
+		list := GetLinkedList(ints)
+
+		slice, err := list.Slice(from, to)
+		checkListModel(t, list, ints, "Slice source")
+		if from < 0 || to < from || to > len(ints) {
+			if !errors.Is(err, ErrOutOfRange) {
+				t.Fatalf("Slice(%d, %d) of %d values: %v", from, to, len(ints), err)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("Slice(%d, %d) of %d values: %v", from, to, len(ints), err)
+		}
+		checkListModel(t, slice, ints[from:to], "Slice")
+
+		// copy does not share nodes with the source
+		slice.Clean()
+		checkListModel(t, list, ints, "Slice source after Clean")
+	})
+}
+
+func FuzzLinkedList_RemoveIf(f *testing.F) {
+	f.Add(intsToBytes([]int{}), 2, false)
+	f.Add(intsToBytes([]int{1, 2, 3, 4}), 2, true)
+	f.Add(intsToBytes([]int{3, 3, 3}), 3, false)
+
+	f.Fuzz(func(t *testing.T, data []byte, mod int, pooled bool) {
+		if len(data) > 3000 {
+			t.Skip()
+		}
+		if mod == 0 {
+			mod = 1
+		}
+		ints := bytesToInts(data, 300)
+		list := bulkList(ints, pooled)
+		pred := func(v int) bool { return v%mod == 0 }
+
+		removed := list.RemoveIf(pred)
+		want := slices.DeleteFunc(slices.Clone(ints), pred)
+		if removed != len(ints)-len(want) {
+			t.Fatalf("RemoveIf count mismatch: got=%d want=%d", removed, len(ints)-len(want))
+		}
+		checkListModel(t, list, want, "RemoveIf")
+		list.AddInTail(Node{value: -1})
+		checkListModel(t, list, append(want, -1), "AddInTail after RemoveIf")
+	})
+}
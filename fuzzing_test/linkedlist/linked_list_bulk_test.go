@@ -0,0 +1,173 @@
+package linkedlist
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestConcatAndSplice(t *testing.T) {
+	tests := []struct {
+		name    string
+		inputL1 []int
+		inputL2 []int
+		after   int // index of node for SpliceAfter
+		concat  []int
+		splice  []int
+	}{
+		{"Test1: ", []int{}, []int{}, -1, []int{}, []int{}},
+		{"Test2: ", []int{}, []int{1, 2}, -1, []int{1, 2}, []int{}},
+		{"Test3: ", []int{1, 2}, []int{}, 0, []int{1, 2}, []int{1, 2}},
+		{"Test4: ", []int{1, 2, 3}, []int{7, 8}, 0, []int{1, 2, 3, 7, 8}, []int{1, 7, 8, 2, 3}},
+		{"Test5: ", []int{1, 2, 3}, []int{7, 8}, 2, []int{1, 2, 3, 7, 8}, []int{1, 2, 3, 7, 8}},
+	}
+
+	for _, test := range tests {
+		l1, l2 := GetLinkedList(test.inputL1), GetLinkedList(test.inputL2)
+		l1.Concat(l2)
+		if got := slices.Collect(l1.All()); !slices.Equal(got, test.concat) || l1.Validate() != nil {
+			t.Errorf("failed %s: concat, got %v", test.name, got)
+		}
+		if l2.Count() != 0 || l2.Validate() != nil {
+			t.Errorf("failed %s: concat, other is not empty", test.name)
+		}
+
+		if test.after < 0 {
+			continue
+		}
+		l1, l2 = GetLinkedList(test.inputL1), GetLinkedList(test.inputL2)
+		if err := l1.SpliceAfter(l1.nodeAt(test.after), l2); err != nil {
+			t.Errorf("failed %s: splice, %v", test.name, err)
+		}
+		if got := slices.Collect(l1.All()); !slices.Equal(got, test.splice) || l1.Validate() != nil {
+			t.Errorf("failed %s: splice, got %v", test.name, got)
+		}
+		if l2.Count() != 0 || l2.Validate() != nil {
+			t.Errorf("failed %s: splice, other is not empty", test.name)
+		}
+	}
+}
+
+func TestBulkHandles(t *testing.T) {
+	l1, l2 := GetLinkedList([]int{1}), GetLinkedList([]int{2, 3})
+	moved, _ := l2.Find(3)
+	l1.Concat(l2)
+	// handle follows the node to the new list
+	if _, err := l1.InsertAfter(moved, Node{value: 4}); err != nil {
+		t.Errorf("failed: handle after concat, %v", err)
+	}
+	if _, err := l2.InsertAfter(moved, Node{value: 5}); !errors.Is(err, ErrForeignNode) {
+		t.Errorf("failed: old list accepts moved handle, %v", err)
+	}
+	if err := l1.SpliceAfter(GetLinkedList([]int{1}).head, l2); !errors.Is(err, ErrForeignNode) {
+		t.Errorf("failed: splice after foreign node, %v", err)
+	}
+
+	// records of moved chains are linked, so handles follow several moves
+	l3, l4 := GetLinkedList([]int{5}), GetLinkedList([]int{6})
+	first, _ := l3.Find(5)
+	l4.Concat(l3)
+	l1.SpliceAfter(l1.head, l4)
+	for _, list := range []*LinkedList{l2, l3, l4} {
+		if err := list.Remove(first); !errors.Is(err, ErrForeignNode) {
+			t.Errorf("failed: old list removes moved handle, %v", err)
+		}
+	}
+	if err := l1.Remove(first); err != nil {
+		t.Errorf("failed: handle after two moves, %v", err)
+	}
+	l3.AddInTail(Node{value: 7})
+	if err := l1.Remove(l3.head); !errors.Is(err, ErrForeignNode) {
+		t.Errorf("failed: new node of emptied list belongs to other list, %v", err)
+	}
+	mustValidate(t, l1, "Concat chain")
+	mustValidate(t, l3, "Concat chain")
+	if got := slices.Collect(l1.All()); !slices.Equal(got, []int{1, 6, 2, 3, 4}) {
+		t.Errorf("failed: concat chain, got %v", got)
+	}
+	l1.Remove(l1.head.next)
+
+	l1.Concat(l1)
+	if got := slices.Collect(l1.All()); !slices.Equal(got, []int{1, 2, 3, 4}) || l1.Validate() != nil {
+		t.Errorf("failed: concat with itself, got %v", got)
+	}
+}
+
+func TestSplitAt(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		index int
+		front []int
+		back  []int
+		err   error
+	}{
+		{"Test1: ", []int{}, 0, []int{}, []int{}, nil},
+		{"Test2: ", []int{1, 2, 3}, 0, []int{}, []int{1, 2, 3}, nil},
+		{"Test3: ", []int{1, 2, 3}, 1, []int{1}, []int{2, 3}, nil},
+		{"Test4: ", []int{1, 2, 3}, 3, []int{1, 2, 3}, []int{}, nil},
+		{"Test5: ", []int{1, 2, 3}, 4, nil, nil, ErrOutOfRange},
+		{"Test6: ", []int{1, 2, 3}, -1, nil, nil, ErrOutOfRange},
+	}
+
+	for _, test := range tests {
+		list := GetLinkedList(test.input)
+		front, back, err := list.SplitAt(test.index)
+		if !errors.Is(err, test.err) {
+			t.Errorf("failed %s: split error, got %v", test.name, err)
+		}
+		if err != nil {
+			continue
+		}
+		if front != list {
+			t.Errorf("failed %s: front is not the list itself", test.name)
+		}
+		mustValidate(t, front, test.name)
+		mustValidate(t, back, test.name)
+		if got := slices.Collect(front.All()); !slices.Equal(got, test.front) {
+			t.Errorf("failed %s: front, got %v", test.name, got)
+		}
+		if got := slices.Collect(back.All()); !slices.Equal(got, test.back) {
+			t.Errorf("failed %s: back, got %v", test.name, got)
+		}
+	}
+}
+
+func TestSliceAndRemoveIf(t *testing.T) {
+	list := GetLinkedList([]int{1, 2, 3, 4, 5, 6})
+	tests := []struct {
+		name string
+		from int
+		to   int
+		want []int
+		err  error
+	}{
+		{"Test1: ", 0, 0, []int{}, nil},
+		{"Test2: ", 1, 4, []int{2, 3, 4}, nil},
+		{"Test3: ", 0, 6, []int{1, 2, 3, 4, 5, 6}, nil},
+		{"Test4: ", 4, 3, nil, ErrOutOfRange},
+		{"Test5: ", 2, 7, nil, ErrOutOfRange},
+	}
+
+	for _, test := range tests {
+		slice, err := list.Slice(test.from, test.to)
+		if !errors.Is(err, test.err) {
+			t.Errorf("failed %s: slice error, got %v", test.name, err)
+		}
+		if err != nil {
+			continue
+		}
+		mustValidate(t, slice, test.name)
+		if got := slices.Collect(slice.All()); !slices.Equal(got, test.want) {
+			t.Errorf("failed %s: slice, got %v", test.name, got)
+		}
+	}
+
+	if removed := list.RemoveIf(func(v int) bool { return v%2 == 0 }); removed != 3 {
+		t.Errorf("failed: remove if, removed %d", removed)
+	}
+	mustValidate(t, list, "RemoveIf")
+	if got := slices.Collect(list.All()); !slices.Equal(got, []int{1, 3, 5}) {
+		t.Errorf("failed: remove if, got %v", got)
+	}
+}
@@ -0,0 +1,147 @@
+package linkedlist
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+
+	"minimize_steps/example3"
+)
+
+// nil list is the same as empty one in comparisons
+
+// Equal compares lengths and values position by position
+// t = O(n), where n = len(list)
+func Equal(l1 *LinkedList, l2 *LinkedList) bool {
+	l1, l2 = orEmpty(l1), orEmpty(l2)
+	return l1.Count() == l2.Count() && firstMismatch(l1, l2) < 0
+}
+
+// Compare is lexicographic ordering in style of slices.Compare:
+// the first different value decides, otherwise the shorter list is less
+// t = O(n), where n = len(list)
+func Compare(l1 *LinkedList, l2 *LinkedList) int {
+	l1, l2 = orEmpty(l1), orEmpty(l2)
+	tempL1, tempL2 := l1.head, l2.head
+	for tempL1 != nil && tempL2 != nil {
+		if c := cmp.Compare(tempL1.value, tempL2.value); c != 0 {
+			return c
+		}
+		tempL1, tempL2 = tempL1.next, tempL2.next
+	}
+	return cmp.Compare(l1.Count(), l2.Count())
+}
+
+type EditOp int
+
+const (
+	EditKeep EditOp = iota
+	EditDelete
+	EditInsert
+)
+
+// Edit is one step of edit script, which turns l1 into l2
+type Edit struct {
+	Op    EditOp
+	Value int
+}
+
+func (e Edit) String() string {
+	return fmt.Sprintf("%c%d", "=-+"[e.Op], e.Value)
+}
+
+// ListDiff is the first mismatch index, -1 for equal lists,
+// and the shortest edit script from l1 to l2
+type ListDiff struct {
+	Index int
+	Edits []Edit
+}
+
+func (d ListDiff) String() string {
+	if d.Index < 0 {
+		return "lists are equal"
+	}
+	edits := make([]string, 0, len(d.Edits))
+	for _, edit := range d.Edits {
+		edits = append(edits, edit.String())
+	}
+	return fmt.Sprintf("first mismatch at %d: [%s]", d.Index, strings.Join(edits, " "))
+}
+
+// Diff finds the shortest edit script: common prefix and suffix are kept
+// as is, and the rest is compared by Myers algorithm of example3.Diff,
+// t = O(n+m+(n'+m')*d), mem = O(n+m+d*d), where n = len(l1), m = len(l2),
+// n', m' - lengths without common prefix and suffix, d = number of changes
+func Diff(l1 *LinkedList, l2 *LinkedList) ListDiff {
+	l1, l2 = orEmpty(l1), orEmpty(l2)
+	index := firstMismatch(l1, l2)
+	if index < 0 {
+		return ListDiff{Index: -1}
+	}
+
+	values1, values2 := valuesOf(l1), valuesOf(l2)
+	n, m := len(values1), len(values2)
+	suffix := 0
+	for suffix < min(n, m)-index && values1[n-1-suffix] == values2[m-1-suffix] {
+		suffix++
+	}
+
+	edits := make([]Edit, 0, n+m-index-suffix)
+	for _, value := range values1[:index] {
+		edits = append(edits, Edit{EditKeep, value})
+	}
+	edits = append(edits, myersEdits(values1[index:n-suffix], values2[index:m-suffix])...)
+	for _, value := range values1[n-suffix:] {
+		edits = append(edits, Edit{EditKeep, value})
+	}
+	return ListDiff{Index: index, Edits: edits}
+}
+
+// myersEdits maps the script of example3.Diff on values
+func myersEdits(a, b []int) []Edit {
+	script := example3.Diff(a, b)
+	edits := make([]Edit, len(script))
+	for i, edit := range script {
+		switch edit.Op {
+		case example3.Keep:
+			edits[i] = Edit{EditKeep, a[edit.IndexA]}
+		case example3.Delete:
+			edits[i] = Edit{EditDelete, a[edit.IndexA]}
+		case example3.Insert:
+			edits[i] = Edit{EditInsert, b[edit.IndexB]}
+		}
+	}
+	return edits
+}
+
+// index of the first different value or the shorter length, -1 for equal lists
+func firstMismatch(l1 *LinkedList, l2 *LinkedList) int {
+	tempL1, tempL2 := l1.head, l2.head
+	index := 0
+	for tempL1 != nil && tempL2 != nil {
+		if tempL1.value != tempL2.value {
+			return index
+		}
+		tempL1, tempL2 = tempL1.next, tempL2.next
+		index++
+	}
+	if tempL1 == nil && tempL2 == nil {
+		return -1
+	}
+	return index
+}
+
+func orEmpty(l *LinkedList) *LinkedList {
+	if l == nil {
+		return &LinkedList{}
+	}
+	return l
+}
+
+func valuesOf(l *LinkedList) []int {
+	values := make([]int, 0, l.Count())
+	for value := range l.All() {
+		values = append(values, value)
+	}
+	return values
+}
@@ -0,0 +1,92 @@
+package linkedlist
+
+import (
+	"slices"
+	"testing"
+)
+
+// lcsLenDP is the O(n*m) table of LCS lengths, so it does not share
+// anything with Myers algorithm in Diff
+func lcsLenDP(a, b []int) int {
+	dist := make([][]int, len(a)+1)
+	for i := range dist {
+		dist[i] = make([]int, len(b)+1)
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if a[i-1] == b[j-1] {
+				dist[i][j] = dist[i-1][j-1] + 1
+			} else {
+				dist[i][j] = max(dist[i-1][j], dist[i][j-1])
+			}
+		}
+	}
+	return dist[len(a)][len(b)]
+}
+
+func FuzzLinkedList_Compare(f *testing.F) {
+	f.Add(intsToBytes([]int{}), intsToBytes([]int{}))
+	f.Add(intsToBytes([]int{}), intsToBytes([]int{1}))
+	f.Add(intsToBytes([]int{1}), intsToBytes([]int{}))
+	f.Add(intsToBytes([]int{1, 2, 3}), intsToBytes([]int{1, 3}))
+	f.Add(intsToBytes([]int{5, 5}), intsToBytes([]int{5, 5}))
+
+	f.Fuzz(func(t *testing.T, data1 []byte, data2 []byte) {
+		if len(data1) > 3000 || len(data2) > 3000 {
+			t.Skip()
+		}
+		// small values, so lists have common parts
+		ints1, ints2 := bytesToInts(data1, 100), bytesToInts(data2, 100)
+		for i := range ints1 {
+			ints1[i] %= 4
+		}
+		for i := range ints2 {
+			ints2[i] %= 4
+		}
+		l1, l2 := GetLinkedList(ints1), GetLinkedList(ints2)
+
+		if got, want := Equal(l1, l2), slices.Equal(ints1, ints2); got != want || EqualLists(l1, l2) != want {
+			t.Fatalf("Equal mismatch: got=%v want=%v l1=%v l2=%v", got, want, ints1, ints2)
+		}
+		if got, want := Compare(l1, l2), slices.Compare(ints1, ints2); got != want {
+			t.Fatalf("Compare mismatch: got=%d want=%d l1=%v l2=%v", got, want, ints1, ints2)
+		}
+		if Compare(l1, l2) != -Compare(l2, l1) {
+			t.Fatalf("Compare is not antisymmetric: l1=%v l2=%v", ints1, ints2)
+		}
+
+		diff := Diff(l1, l2)
+		wantIndex := -1
+		for i := range max(len(ints1), len(ints2)) {
+			if i >= len(ints1) || i >= len(ints2) || ints1[i] != ints2[i] {
+				wantIndex = i
+				break
+			}
+		}
+		if diff.Index != wantIndex {
+			t.Fatalf("Diff index mismatch: got=%d want=%d l1=%v l2=%v", diff.Index, wantIndex, ints1, ints2)
+		}
+
+		// edit script turns l1 into l2, and it is the shortest one
+		// when it keeps as many values as LCS has
+		var from, to []int
+		kept := 0
+		for _, edit := range diff.Edits {
+			switch edit.Op {
+			case EditKeep:
+				from, to = append(from, edit.Value), append(to, edit.Value)
+				kept++
+			case EditDelete:
+				from = append(from, edit.Value)
+			case EditInsert:
+				to = append(to, edit.Value)
+			}
+		}
+		if diff.Index >= 0 && (!slices.Equal(from, ints1) || !slices.Equal(to, ints2)) {
+			t.Fatalf("Diff edits mismatch: %v l1=%v l2=%v", diff, ints1, ints2)
+		}
+		if want := lcsLenDP(ints1, ints2); diff.Index >= 0 && kept != want {
+			t.Fatalf("Diff edits are not the shortest: kept %d, LCS %d: %v l1=%v l2=%v", kept, want, diff, ints1, ints2)
+		}
+	})
+}
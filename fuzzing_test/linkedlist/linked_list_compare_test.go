@@ -0,0 +1,77 @@
+package linkedlist
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestEqualAndCompare(t *testing.T) {
+	tests := []struct {
+		name    string
+		inputL1 *LinkedList
+		inputL2 *LinkedList
+		equal   bool
+		compare int
+	}{
+		{"Test1: ", GetLinkedList([]int{}), GetLinkedList([]int{}), true, 0},
+		{"Test2: ", GetLinkedList([]int{}), GetLinkedList([]int{1}), false, -1},
+		{"Test3: ", GetLinkedList([]int{1}), GetLinkedList([]int{}), false, 1},
+		{"Test4: ", GetLinkedList([]int{1, 2, 3}), GetLinkedList([]int{1, 2, 3}), true, 0},
+		{"Test5: ", GetLinkedList([]int{1, 5, 3}), GetLinkedList([]int{1, 2, 3}), false, 1},
+		{"Test6: ", GetLinkedList([]int{1, 2}), GetLinkedList([]int{1, 2, 0}), false, -1},
+		{"Test7: ", GetLinkedList([]int{1, 2, 1}), GetLinkedList([]int{1, 3, 1}), false, -1},
+		{"Test8: ", nil, GetLinkedList([]int{}), true, 0},
+	}
+
+	for _, test := range tests {
+		if got := Equal(test.inputL1, test.inputL2); got != test.equal {
+			t.Errorf("failed %s: equal, got %v", test.name, got)
+		}
+		if got := EqualLists(test.inputL1, test.inputL2); got != test.equal {
+			t.Errorf("failed %s: equal lists, got %v", test.name, got)
+		}
+		if got := Compare(test.inputL1, test.inputL2); got != test.compare {
+			t.Errorf("failed %s: compare, got %d, want %d", test.name, got, test.compare)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name    string
+		inputL1 []int
+		inputL2 []int
+		want    string
+	}{
+		{"Test1: ", []int{}, []int{}, "lists are equal"},
+		{"Test2: ", []int{}, []int{1, 2}, "first mismatch at 0: [+1 +2]"},
+		{"Test3: ", []int{1, 2, 3}, []int{1, 3}, "first mismatch at 1: [=1 -2 =3]"},
+		{"Test4: ", []int{1, 2, 3}, []int{1, 2, 4}, "first mismatch at 2: [=1 =2 -3 +4]"},
+		{"Test5: ", []int{1, 2}, []int{1, 2, 3}, "first mismatch at 2: [=1 =2 +3]"},
+	}
+
+	for _, test := range tests {
+		if got := Diff(GetLinkedList(test.inputL1), GetLinkedList(test.inputL2)).String(); got != test.want {
+			t.Errorf("failed %s: diff, got %q, want %q", test.name, got, test.want)
+		}
+	}
+}
+
+func TestDiffLargeLists(t *testing.T) {
+	// O(n*m) table would take about 80 GB here
+	values := make([]int, 100_000)
+	for i := range values {
+		values[i] = i
+	}
+	l1 := GetLinkedList(values)
+	values[50_000] = -1
+	l2 := GetLinkedList(values)
+
+	diff := Diff(l1, l2)
+	if diff.Index != 50_000 || len(diff.Edits) != 100_001 {
+		t.Fatalf("failed: large diff, index %d, %d edits", diff.Index, len(diff.Edits))
+	}
+	if got, want := diff.Edits[50_000:50_002], []Edit{{EditDelete, 50_000}, {EditInsert, -1}}; !slices.Equal(got, want) {
+		t.Errorf("failed: large diff, got %v", got)
+	}
+}
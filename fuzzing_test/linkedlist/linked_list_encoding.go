@@ -0,0 +1,70 @@
+package linkedlist
+
+import (
+	"encoding"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// binary layout is the same as in fuzz helpers intsToBytes/bytesToInts:
+// values one by one as little-endian int16 without header,
+// so fuzz corpus can be decoded as is, and values out of int16 are rejected
+const valueSize = 2
+
+var (
+	_ encoding.BinaryMarshaler   = (*LinkedList)(nil)
+	_ encoding.BinaryUnmarshaler = (*LinkedList)(nil)
+	_ json.Marshaler             = (*LinkedList)(nil)
+	_ json.Unmarshaler           = (*LinkedList)(nil)
+)
+
+// t = O(n), where n = len(list)
+func (l *LinkedList) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, valueSize*l.Count())
+	for indx, value := range l.Enumerate() {
+		if value < math.MinInt16 || value > math.MaxInt16 {
+			return nil, &OverflowError{Op: "marshal binary", Index: indx}
+		}
+		data = binary.LittleEndian.AppendUint16(data, uint16(int16(value)))
+	}
+	return data, nil
+}
+
+// replaces values of the list, list is not changed on error
+// t = O(n), where n = len(data)
+func (l *LinkedList) UnmarshalBinary(data []byte) error {
+	if len(data)%valueSize != 0 {
+		return fmt.Errorf("%w: %d bytes is not a multiple of %d", ErrInvalidEncoding, len(data), valueSize)
+	}
+	l.Clean()
+	for indx := 0; indx < len(data); indx += valueSize {
+		value := int16(binary.LittleEndian.Uint16(data[indx : indx+valueSize]))
+		l.AddInTail(Node{value: int(value)})
+	}
+	return nil
+}
+
+// list is encoded as JSON array, empty list as []
+func (l *List[T]) MarshalJSON() ([]byte, error) {
+	values := make([]T, 0, l.Count())
+	for value := range l.All() {
+		values = append(values, value)
+	}
+	return json.Marshal(values)
+}
+
+// replaces values of the list, null is decoded as empty list,
+// list is not changed on error
+func (l *List[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	l.Clean()
+	for _, value := range values {
+		l.AddInTail(ListNode[T]{value: value})
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package linkedlist
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func FuzzLinkedList_BinaryRoundTrip(f *testing.F) {
+	f.Add(intsToBytes([]int{}))
+	f.Add(intsToBytes([]int{1, 2, 3}))
+	f.Add(intsToBytes([]int{-1, 0, 1, -1, 32767, -32768}))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if len(data) > 3000 {
+			t.Skip()
+		}
+		ints := bytesToInts(data, 300)
+		list := GetLinkedList(ints)
+
+		encoded, err := list.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary failed: %v", err)
+		}
+		var decoded LinkedList
+		if err := decoded.UnmarshalBinary(encoded); err != nil {
+			t.Fatalf("UnmarshalBinary failed: %v", err)
+		}
+		mustValidate(t, &decoded, "UnmarshalBinary")
+		if got := listToSlice(&decoded, len(ints)+1); !slices.Equal(got, ints) {
+			t.Fatalf("binary round trip mismatch: got=%v want=%v", got, ints)
+		}
+
+		encoded, err = json.Marshal(list)
+		if err != nil {
+			t.Fatalf("MarshalJSON failed: %v", err)
+		}
+		decoded = LinkedList{}
+		if err := json.Unmarshal(encoded, &decoded); err != nil {
+			t.Fatalf("UnmarshalJSON failed: %v", err)
+		}
+		mustValidate(t, &decoded, "UnmarshalJSON")
+		if got := listToSlice(&decoded, len(ints)+1); !slices.Equal(got, ints) {
+			t.Fatalf("json round trip mismatch: got=%v want=%v json=%s", got, ints, encoded)
+		}
+	})
+}
+
+func FuzzLinkedList_UnmarshalBinary(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{1, 2, 3})
+	f.Add([]byte{1, 0, 0xfe, 0xff})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if len(data) > 3000 {
+			t.Skip()
+		}
+		var list LinkedList
+		err := list.UnmarshalBinary(data)
+		if len(data)%valueSize != 0 {
+			if err == nil {
+				t.Fatalf("malformed data of %d bytes decoded without error", len(data))
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("UnmarshalBinary failed: %v", err)
+		}
+		mustValidate(t, &list, "UnmarshalBinary")
+
+		if got, want := listToSlice(&list, len(data)), bytesToInts(data, len(data)); !slices.Equal(got, want) {
+			t.Fatalf("decode mismatch with bytesToInts: got=%v want=%v", got, want)
+		}
+		encoded, _ := list.MarshalBinary()
+		if !slices.Equal(encoded, data) {
+			t.Fatalf("encode(decode(x)) mismatch: got=%v want=%v", encoded, data)
+		}
+	})
+}
+
+func FuzzLinkedList_UnmarshalJSON(f *testing.F) {
+	f.Add(`[]`)
+	f.Add(`[1,2,3]`)
+	f.Add(`null`)
+	f.Add(`[1,"a"]`)
+	f.Add(`{"a":1}`)
+	f.Add(`[1e3, 1.5]`)
+
+	f.Fuzz(func(t *testing.T, data string) {
+		if len(data) > 3000 {
+			t.Skip()
+		}
+		var list LinkedList
+		if err := json.Unmarshal([]byte(data), &list); err != nil {
+			return
+		}
+		mustValidate(t, &list, "UnmarshalJSON")
+
+		encoded, err := json.Marshal(&list)
+		if err != nil {
+			t.Fatalf("MarshalJSON failed: %v", err)
+		}
+		var decoded LinkedList
+		if err := json.Unmarshal(encoded, &decoded); err != nil {
+			t.Fatalf("decode of own encoding failed: %v, json=%s", err, encoded)
+		}
+		if !EqualLists(&list, &decoded) {
+			t.Fatalf("json round trip mismatch: input=%s encoded=%s", data, encoded)
+		}
+	})
+}
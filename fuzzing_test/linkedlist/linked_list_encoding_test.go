@@ -0,0 +1,126 @@
+package linkedlist
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestBinaryEncoding(t *testing.T) {
+	tests := []struct {
+		name  string
+		input *LinkedList
+	}{
+		{"Test1: ", GetLinkedList([]int{})},
+		{"Test2: ", GetLinkedList([]int{22, -3, 2, 45, 6})},
+		{"Test3: ", GetLinkedList([]int{math.MaxInt16, math.MinInt16, 0})},
+	}
+
+	for _, test := range tests {
+		data, err := test.input.MarshalBinary()
+		if err != nil || len(data) != valueSize*test.input.Count() {
+			t.Fatalf("failed %s: marshal binary, %d bytes, err %v", test.name, len(data), err)
+		}
+		var got LinkedList
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatalf("failed %s: unmarshal binary, err %v", test.name, err)
+		}
+		if !EqualLists(&got, test.input) {
+			t.Errorf("failed %s: binary round trip, got %v", test.name, slices.Collect(got.All()))
+		}
+	}
+
+	// the same layout as intsToBytes in both directions
+	data, _ := GetLinkedList([]int{1, -2}).MarshalBinary()
+	if want := []byte{1, 0, 0xfe, 0xff}; !slices.Equal(data, want) || !slices.Equal(data, intsToBytes([]int{1, -2})) {
+		t.Errorf("failed: binary layout, got %v", data)
+	}
+	var list LinkedList
+	if err := list.UnmarshalBinary(intsToBytes([]int{1, 2, 3})); err != nil {
+		t.Errorf("failed: unmarshal fuzz helper bytes, err %v", err)
+	}
+	if got := slices.Collect(list.All()); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("failed: unmarshal fuzz helper bytes, got %v", got)
+	}
+}
+
+func TestBinaryEncodingOverflow(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		index int
+	}{
+		{"Test1: ", []int{1, math.MaxInt16 + 1}, 1},
+		{"Test2: ", []int{math.MinInt16 - 1, 1}, 0},
+		{"Test3: ", []int{0, 0, math.MaxInt}, 2},
+	}
+
+	for _, test := range tests {
+		data, err := GetLinkedList(test.input).MarshalBinary()
+		var overflow *OverflowError
+		if !errors.As(err, &overflow) || !errors.Is(err, ErrOverflow) || overflow.Index != test.index || data != nil {
+			t.Errorf("failed %s: marshal out of int16, err %v", test.name, err)
+		}
+	}
+}
+
+func TestBinaryEncodingMalformed(t *testing.T) {
+	list := GetLinkedList([]int{22, 3})
+	if err := list.UnmarshalBinary([]byte{1, 2, 3}); !errors.Is(err, ErrInvalidEncoding) {
+		t.Errorf("failed: malformed data, err %v", err)
+	}
+	if got := slices.Collect(list.All()); !slices.Equal(got, []int{22, 3}) {
+		t.Errorf("failed: malformed data changed list, got %v", got)
+	}
+}
+
+func TestJSONEncoding(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want []int
+	}{
+		{"Test1: ", `[]`, []int{}},
+		{"Test2: ", `[22,-3,2]`, []int{22, -3, 2}},
+		{"Test3: ", `null`, []int{}},
+	}
+
+	for _, test := range tests {
+		list := GetLinkedList([]int{1})
+		if err := json.Unmarshal([]byte(test.json), list); err != nil {
+			t.Fatalf("failed %s: unmarshal json, err %v", test.name, err)
+		}
+		if !EqualLists(list, GetLinkedList(test.want)) {
+			t.Errorf("failed %s: unmarshal json, got %v", test.name, slices.Collect(list.All()))
+		}
+		data, err := json.Marshal(list)
+		if err != nil {
+			t.Fatalf("failed %s: marshal json, err %v", test.name, err)
+		}
+		if wantJSON, _ := json.Marshal(test.want); string(data) != string(wantJSON) {
+			t.Errorf("failed %s: marshal json, got %s, want %s", test.name, data, wantJSON)
+		}
+	}
+
+	// list as field of other struct
+	type message struct {
+		IDs *List[string] `json:"ids"`
+	}
+	var msg message
+	if err := json.Unmarshal([]byte(`{"ids":["a","b"]}`), &msg); err != nil {
+		t.Fatalf("failed: unmarshal struct, err %v", err)
+	}
+	if data, _ := json.Marshal(msg); string(data) != `{"ids":["a","b"]}` {
+		t.Errorf("failed: struct round trip, got %s", data)
+	}
+
+	list := GetLinkedList([]int{1})
+	if err := json.Unmarshal([]byte(`[1,"a"]`), list); err == nil {
+		t.Errorf("failed: malformed json without error")
+	}
+	if got := slices.Collect(list.All()); !slices.Equal(got, []int{1}) {
+		t.Errorf("failed: malformed json changed list, got %v", got)
+	}
+}
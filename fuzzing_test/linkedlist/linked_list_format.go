@@ -0,0 +1,308 @@
+package linkedlist
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// formatting never trusts the structure: a list broken by a bug is printed
+// as it is linked, cycles are cut at the first repeated node, so printing
+// is safe in fuzz failures
+
+var (
+	_ fmt.Formatter = (*List[int])(nil)
+	_ fmt.Stringer  = (*List[int])(nil)
+	_ fmt.Formatter = (*DoublyList[int])(nil)
+	_ fmt.Stringer  = (*DoublyList[int])(nil)
+	_ fmt.Formatter = (*LinkedList)(nil)
+)
+
+// listLayout is the list as it is linked from head
+type listLayout[T any] struct {
+	values []T
+	head   int // 0, -1 for empty list
+	tail   int // index of tail node, -1 if tail is not reachable from head
+	cycle  int // index the last node points back to, -1 without cycle
+	length int // length field, may differ from len(values) in broken list
+}
+
+// t = O(n), mem = O(n), where n = len(list)
+func (l *List[T]) layout() listLayout[T] {
+	lay := listLayout[T]{head: -1, tail: -1, cycle: -1, length: l.length}
+	if l.head != nil {
+		lay.head = 0
+	}
+	seen := make(map[*ListNode[T]]int)
+	for tempNode := l.head; tempNode != nil; tempNode = tempNode.next {
+		if index, ok := seen[tempNode]; ok {
+			lay.cycle = index
+			break
+		}
+		seen[tempNode] = len(lay.values)
+		if tempNode == l.tail {
+			lay.tail = len(lay.values)
+		}
+		lay.values = append(lay.values, tempNode.value)
+	}
+	return lay
+}
+
+// t = O(n), mem = O(n), where n = len(list)
+func (l *DoublyList[T]) layout() listLayout[T] {
+	lay := listLayout[T]{head: -1, tail: -1, cycle: -1, length: l.length}
+	if l.head != nil {
+		lay.head = 0
+	}
+	seen := make(map[*DoublyNode[T]]int)
+	for tempNode := l.head; tempNode != nil; tempNode = tempNode.next {
+		if index, ok := seen[tempNode]; ok {
+			lay.cycle = index
+			break
+		}
+		seen[tempNode] = len(lay.values)
+		if tempNode == l.tail {
+			lay.tail = len(lay.values)
+		}
+		lay.values = append(lay.values, tempNode.value)
+	}
+	return lay
+}
+
+// format writes
+//
+//	%v   [1 2 3]
+//	%+v  [head:1 2 tail:3] len=3
+//	%#v  &linkedlist.List[int]{length: 3, head: 0, tail: 2, values: {1, 2, 3}}
+//
+// head and tail are -1, when there is no such node;
+// any other verb is applied to values, as fmt does for slices;
+// cycle is written as "...cycle to #i" after the last node
+func (lay listLayout[T]) format(f fmt.State, verb rune, typeName string) {
+	if verb == 'v' && f.Flag('#') {
+		fmt.Fprintf(f, "&%s{length: %d, head: %d, tail: %d, values: {", typeName, lay.length, lay.head, lay.tail)
+		for i, value := range lay.values {
+			if i > 0 {
+				io.WriteString(f, ", ")
+			}
+			fmt.Fprintf(f, "%#v", value)
+		}
+		if lay.cycle >= 0 {
+			fmt.Fprintf(f, ", /* cycle to #%d */", lay.cycle)
+		}
+		io.WriteString(f, "}}")
+		return
+	}
+
+	marks := verb == 'v' && f.Flag('+')
+	valueFormat := "%v"
+	if verb != 'v' {
+		valueFormat = fmt.FormatString(f, verb)
+	}
+	io.WriteString(f, "[")
+	for i, value := range lay.values {
+		if i > 0 {
+			io.WriteString(f, " ")
+		}
+		if marks {
+			switch {
+			case i == 0 && i == lay.tail:
+				io.WriteString(f, "head,tail:")
+			case i == 0:
+				io.WriteString(f, "head:")
+			case i == lay.tail:
+				io.WriteString(f, "tail:")
+			}
+		}
+		fmt.Fprintf(f, valueFormat, value)
+	}
+	if lay.cycle >= 0 {
+		fmt.Fprintf(f, " ...cycle to #%d", lay.cycle)
+	}
+	io.WriteString(f, "]")
+	if marks {
+		fmt.Fprintf(f, " len=%d", lay.length)
+	}
+}
+
+// Format implements fmt.Formatter, see listLayout.format for the forms
+// t = O(n), where n = len(list)
+func (l *List[T]) Format(f fmt.State, verb rune) {
+	if l == nil {
+		formatNil(f, verb, l)
+		return
+	}
+	l.layout().format(f, verb, fmt.Sprintf("%T", *l))
+}
+
+// t = O(n), where n = len(list)
+func (l *List[T]) String() string {
+	return fmt.Sprintf("%v", l)
+}
+
+// Format is the same as for List, but %#v is written with LinkedList type
+// t = O(n), where n = len(list)
+func (l *LinkedList) Format(f fmt.State, verb rune) {
+	if l == nil {
+		formatNil(f, verb, l)
+		return
+	}
+	l.layout().format(f, verb, fmt.Sprintf("%T", *l))
+}
+
+// Format implements fmt.Formatter, see listLayout.format for the forms
+// t = O(n), where n = len(list)
+func (l *DoublyList[T]) Format(f fmt.State, verb rune) {
+	if l == nil {
+		formatNil(f, verb, l)
+		return
+	}
+	l.layout().format(f, verb, fmt.Sprintf("%T", *l))
+}
+
+// t = O(n), where n = len(list)
+func (l *DoublyList[T]) String() string {
+	return fmt.Sprintf("%v", l)
+}
+
+func formatNil(f fmt.State, verb rune, l any) {
+	if verb == 'v' && f.Flag('#') {
+		fmt.Fprintf(f, "(%T)(nil)", l)
+		return
+	}
+	io.WriteString(f, "<nil>")
+}
+
+// dot output: nodes n0, n1, ... in the order from head, next links are solid
+// and prev links are dashed; everything breaking the invariants is red:
+// cycles, tail which is not reachable from head, nodes of other lists,
+// prev links which do not point to the previous node
+
+type dotWriter struct {
+	b strings.Builder
+}
+
+func (d *dotWriter) line(format string, args ...any) {
+	d.b.WriteString("\t")
+	fmt.Fprintf(&d.b, format, args...)
+	d.b.WriteString("\n")
+}
+
+func (d *dotWriter) begin(length int) {
+	d.b.WriteString("digraph list {\n")
+	d.line("rankdir=LR;")
+	d.line("label=%s;", strconv.Quote(fmt.Sprintf("length=%d", length)))
+	d.line("node [shape=box];")
+	d.line("head [shape=plaintext];")
+	d.line("tail [shape=plaintext];")
+}
+
+func (d *dotWriter) node(name string, value any, broken string) {
+	if broken != "" {
+		d.line("%s [label=%s, color=red, xlabel=%s];", name, strconv.Quote(fmt.Sprint(value)), strconv.Quote(broken))
+		return
+	}
+	d.line("%s [label=%s];", name, strconv.Quote(fmt.Sprint(value)))
+}
+
+func (d *dotWriter) end(w io.Writer) error {
+	d.b.WriteString("}\n")
+	_, err := io.WriteString(w, d.b.String())
+	return err
+}
+
+// WriteDOT writes Graphviz graph of the list as it is linked,
+// e.g. for a list found by a fuzzer: dot -Tsvg list.dot > list.svg,
+// it does not change the list, as Validate
+// t = O(n*r), mem = O(n), where n = len(list), r - length of owner record chains
+func (l *List[T]) WriteDOT(w io.Writer) error {
+	var d dotWriter
+	d.begin(l.length)
+	seen := make(map[*ListNode[T]]string)
+	var last string
+	for tempNode := l.head; tempNode != nil; tempNode = tempNode.next {
+		if name, ok := seen[tempNode]; ok {
+			d.line("%s -> %s [color=red, label=\"cycle\"];", last, name)
+			break
+		}
+		name := "n" + strconv.Itoa(len(seen))
+		seen[tempNode] = name
+		broken := ""
+		if !l.ownsReadOnly(tempNode) {
+			broken = "foreign"
+		}
+		d.node(name, tempNode.value, broken)
+		if last == "" {
+			d.line("head -> %s;", name)
+		} else {
+			d.line("%s -> %s;", last, name)
+		}
+		last = name
+	}
+	if l.tail != nil {
+		name, ok := seen[l.tail]
+		if !ok {
+			name = "lost_tail"
+			d.node(name, l.tail.value, "unreachable")
+		}
+		d.line("tail -> %s;", name)
+	}
+	return d.end(w)
+}
+
+// WriteDOT writes Graphviz graph of the list as it is linked,
+// prev links are dashed
+// t = O(n), mem = O(n), where n = len(list)
+func (l *DoublyList[T]) WriteDOT(w io.Writer) error {
+	var d dotWriter
+	d.begin(l.length)
+	seen := make(map[*DoublyNode[T]]string)
+	var lastNode *DoublyNode[T]
+	var last string
+	for tempNode := l.head; tempNode != nil; tempNode = tempNode.next {
+		if name, ok := seen[tempNode]; ok {
+			d.line("%s -> %s [color=red, label=\"cycle\"];", last, name)
+			break
+		}
+		name := "n" + strconv.Itoa(len(seen))
+		seen[tempNode] = name
+		broken := ""
+		if tempNode.list != l {
+			broken = "foreign"
+		}
+		d.node(name, tempNode.value, broken)
+		if last == "" {
+			d.line("head -> %s;", name)
+		} else {
+			d.line("%s -> %s;", last, name)
+		}
+		if tempNode.prev != lastNode {
+			d.line("%s -> %s [style=dashed, color=red];", name, dotPrevName(seen, tempNode.prev))
+		} else if lastNode != nil {
+			d.line("%s -> %s [style=dashed];", name, last)
+		}
+		lastNode, last = tempNode, name
+	}
+	if l.tail != nil {
+		name, ok := seen[l.tail]
+		if !ok {
+			name = "lost_tail"
+			d.node(name, l.tail.value, "unreachable")
+		}
+		d.line("tail -> %s;", name)
+	}
+	return d.end(w)
+}
+
+// dotPrevName names target of a wrong prev link, which is either
+// a node already written or a node outside of the list
+func dotPrevName[T any](seen map[*DoublyNode[T]]string, prev *DoublyNode[T]) string {
+	if prev == nil {
+		return "nil"
+	}
+	if name, ok := seen[prev]; ok {
+		return name
+	}
+	return fmt.Sprintf("%q", fmt.Sprintf("unknown %v", prev.value))
+}
@@ -0,0 +1,43 @@
+package linkedlist
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func FuzzLinkedList_Format(f *testing.F) {
+	f.Add(intsToBytes([]int{}), -1)
+	f.Add(intsToBytes([]int{1, 2, 3}), -1)
+	f.Add(intsToBytes([]int{1, 2, 3}), 1)
+
+	f.Fuzz(func(t *testing.T, data []byte, cycleTo int) {
+		if len(data) > 3000 {
+			t.Skip()
+		}
+		ints := bytesToInts(data, 100)
+		list := GetLinkedList(ints)
+		if got, want := fmt.Sprint(list), fmt.Sprint(ints); got != want {
+			t.Fatalf("%%v mismatch: got=%s want=%s", got, want)
+		}
+		if len(ints) == 0 || cycleTo < 0 {
+			return
+		}
+
+		// formatting of list with cycle must stop and point to the cycle
+		cycleTo %= len(ints)
+		tempNode := list.head
+		for range cycleTo {
+			tempNode = tempNode.next
+		}
+		list.tail.next = tempNode
+		want := strings.TrimSuffix(fmt.Sprint(ints), "]") + fmt.Sprintf(" ...cycle to #%d]", cycleTo)
+		if got := fmt.Sprint(list); got != want {
+			t.Fatalf("%%v with cycle mismatch: got=%s want=%s", got, want)
+		}
+		var b strings.Builder
+		if err := list.WriteDOT(&b); err != nil || strings.Count(b.String(), "label=\"cycle\"") != 1 {
+			t.Fatalf("WriteDOT with cycle to %d: err=%v\n%s", cycleTo, err, b.String())
+		}
+	})
+}
@@ -0,0 +1,116 @@
+package linkedlist
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	withCycle := GetLinkedList([]int{1, 2, 3})
+	withCycle.tail.next = withCycle.head.next
+	lostTail := GetLinkedList([]int{1, 2, 3})
+	lostTail.head.next.next = nil
+
+	tests := []struct {
+		name   string
+		format string
+		input  *LinkedList
+		want   string
+	}{
+		{"Test1: ", "%v", GetLinkedList([]int{1, 2, 3}), "[1 2 3]"},
+		{"Test2: ", "%v", GetLinkedList([]int{}), "[]"},
+		{"Test3: ", "%+v", GetLinkedList([]int{1, 2, 3}), "[head:1 2 tail:3] len=3"},
+		{"Test4: ", "%+v", GetLinkedList([]int{7}), "[head,tail:7] len=1"},
+		{"Test5: ", "%#v", GetLinkedList([]int{1, 2}), "&linkedlist.LinkedList{length: 2, head: 0, tail: 1, values: {1, 2}}"},
+		{"Test6: ", "%x", GetLinkedList([]int{10, 255}), "[a ff]"},
+		{"Test7: ", "%v", withCycle, "[1 2 3 ...cycle to #1]"},
+		{"Test8: ", "%+v", withCycle, "[head:1 2 tail:3 ...cycle to #1] len=3"},
+		{"Test9: ", "%#v", withCycle, "&linkedlist.LinkedList{length: 3, head: 0, tail: 2, values: {1, 2, 3, /* cycle to #1 */}}"},
+		{"Test10: ", "%+v", lostTail, "[head:1 2] len=3"},
+		{"Test11: ", "%v", nil, "<nil>"},
+		{"Test12: ", "%#v", nil, "(*linkedlist.List[int])(nil)"},
+		{"Test13: ", "%#v", GetLinkedList([]int{}), "&linkedlist.LinkedList{length: 0, head: -1, tail: -1, values: {}}"},
+	}
+
+	for _, test := range tests {
+		var got string
+		if test.input == nil {
+			got = fmt.Sprintf(test.format, (*List[int])(nil))
+		} else {
+			got = fmt.Sprintf(test.format, test.input)
+		}
+		if got != test.want {
+			t.Errorf("failed %s: format %s, got %q, want %q", test.name, test.format, got, test.want)
+		}
+	}
+}
+
+func TestFormatDoubly(t *testing.T) {
+	list := GetDoublyList([]string{"a", "b"})
+	if got, want := list.String(), "[a b]"; got != want {
+		t.Errorf("failed String: got %q, want %q", got, want)
+	}
+	if got, want := fmt.Sprintf("%#v", list), `&linkedlist.DoublyList[string]{length: 2, head: 0, tail: 1, values: {"a", "b"}}`; got != want {
+		t.Errorf("failed %%#v: got %q, want %q", got, want)
+	}
+	if got, want := fmt.Sprintf("%#v", GetDoublyList([]string{})), "&linkedlist.DoublyList[string]{length: 0, head: -1, tail: -1, values: {}}"; got != want {
+		t.Errorf("failed %%#v of empty list: got %q, want %q", got, want)
+	}
+	list.tail.next = list.head
+	if got, want := fmt.Sprintf("%+v", list), "[head:a tail:b ...cycle to #0] len=2"; got != want {
+		t.Errorf("failed cycle: got %q, want %q", got, want)
+	}
+}
+
+func TestWriteDOT(t *testing.T) {
+	withCycle := GetLinkedList([]int{1, 2, 3})
+	withCycle.tail.next = withCycle.head
+	lostTail := GetLinkedList([]int{1, 2, 3})
+	lostTail.head.next = nil
+	foreign := GetLinkedList([]int{1, 2})
+	foreign.tail.owner = nil
+
+	tests := []struct {
+		name  string
+		input *LinkedList
+		want  []string
+	}{
+		{"Test1: ", GetLinkedList([]int{}), []string{"digraph list {", `label="length=0";`}},
+		{"Test2: ", GetLinkedList([]int{1, 2}), []string{`n0 [label="1"];`, `n1 [label="2"];`, "head -> n0;", "n0 -> n1;", "tail -> n1;"}},
+		{"Test3: ", withCycle, []string{"n2 -> n0 [color=red, label=\"cycle\"];", "tail -> n2;"}},
+		{"Test4: ", lostTail, []string{`lost_tail [label="3", color=red, xlabel="unreachable"];`, "tail -> lost_tail;"}},
+		{"Test5: ", foreign, []string{`n1 [label="2", color=red, xlabel="foreign"];`}},
+	}
+
+	for _, test := range tests {
+		var b strings.Builder
+		if err := test.input.WriteDOT(&b); err != nil {
+			t.Fatalf("failed %s: WriteDOT, %v", test.name, err)
+		}
+		got := b.String()
+		if !strings.HasSuffix(got, "}\n") {
+			t.Errorf("failed %s: graph is not closed\n%s", test.name, got)
+		}
+		for _, want := range test.want {
+			if !strings.Contains(got, want) {
+				t.Errorf("failed %s: no %q in\n%s", test.name, want, got)
+			}
+		}
+	}
+}
+
+func TestWriteDOTDoubly(t *testing.T) {
+	list := GetDoublyList([]int{1, 2, 3})
+	list.tail.prev = list.head
+	var b strings.Builder
+	if err := list.WriteDOT(&b); err != nil {
+		t.Fatalf("failed WriteDOT: %v", err)
+	}
+	got := b.String()
+	for _, want := range []string{"n1 -> n0 [style=dashed];", "n2 -> n0 [style=dashed, color=red];", "tail -> n2;"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("failed doubly: no %q in\n%s", want, got)
+		}
+	}
+}
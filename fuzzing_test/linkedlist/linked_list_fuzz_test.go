@@ -2,6 +2,8 @@ package linkedlist
 
 import (
 	"encoding/binary"
+	"errors"
+	"slices"
 	"testing"
 )
 
@@ -26,13 +28,19 @@ func intsToBytes(ints []int) []byte {
 	return out
 }
 
+func mustValidate(t *testing.T, l interface{ Validate() error }, op string) {
+	t.Helper()
+	if err := l.Validate(); err != nil {
+		t.Fatalf("after %s: %v", op, err)
+	}
+}
+
+// limit stops traversal of broken list with cycle
 func listToSlice(l *LinkedList, limit int) []int {
 	out := make([]int, 0)
-	steps := 0
-	for n := l.head; n != nil; n = n.next {
-		out = append(out, n.value)
-		steps++
-		if steps > limit {
+	for value := range l.All() {
+		out = append(out, value)
+		if len(out) > limit {
 			break
 		}
 	}
@@ -51,6 +59,7 @@ func FuzzGetLinkedList(f *testing.F) {
 		}
 
 		list := GetLinkedList(ints)
+		mustValidate(t, list, "GetLinkedList")
 
 		if actualLen, expectedLen := list.Count(), len(ints); actualLen != expectedLen {
 			t.Fatalf("Count mismatch: got=%d want=%d", actualLen, expectedLen)
@@ -98,6 +107,7 @@ func FuzzAddInTail(f *testing.F) {
 		var l LinkedList
 		for _, v := range ints {
 			l.AddInTail(Node{value: v})
+			mustValidate(t, &l, "AddInTail")
 		}
 
 		if got, want := l.Count(), len(ints); got != want {
@@ -185,8 +195,11 @@ func FuzzLinkedList_Find(f *testing.F) {
 			if err == nil {
 				t.Fatalf("Find should fail, but err=nil (target=%d ints=%v gotNode=%v)", target, ints, gotNode)
 			}
-			if gotNode.value != -1 {
-				t.Fatalf("Find miss should return sentinel -1, got=%d", gotNode.value)
+			if !errors.Is(err, ErrNotFound) {
+				t.Fatalf("Find miss should return ErrNotFound, got err=%v", err)
+			}
+			if gotNode != nil {
+				t.Fatalf("Find miss should return nil node, got=%d", gotNode.value)
 			}
 		}
 	})
@@ -253,6 +266,7 @@ func FuzzLinkedList_Delete(f *testing.F) {
 		list := GetLinkedList(ints)
 
 		list.Delete(target, all)
+		mustValidate(t, list, "Delete")
 
 		want := make([]int, 0, len(ints))
 		deleted := false
@@ -343,6 +357,7 @@ func FuzzLinkedList_Insert_AfterExistingNode(f *testing.F) {
 		}
 
 		list.Insert(after, Node{value: addVal})
+		mustValidate(t, list, "Insert")
 
 		want := make([]int, 0, len(ints)+1)
 		want = append(want, ints[:afterIndex+1]...)
@@ -395,6 +410,7 @@ func FuzzLinkedList_InsertFirst(f *testing.F) {
 		list := GetLinkedList(ints)
 
 		list.InsertFirst(Node{value: firstVal})
+		mustValidate(t, list, "InsertFirst")
 
 		want := make([]int, 0, len(ints)+1)
 		want = append(want, firstVal)
@@ -432,3 +448,98 @@ func FuzzLinkedList_InsertFirst(f *testing.F) {
 		}
 	})
 }
+
+func FuzzLinkedList_InsertAfter_Handle(f *testing.F) {
+	f.Add(intsToBytes([]int{1}), 0, 9)
+	f.Add(intsToBytes([]int{7, 7, 7}), 2, 99)
+	f.Add(intsToBytes([]int{5, 6, 5, 6}), 2, -1)
+
+	f.Fuzz(func(t *testing.T, data []byte, afterIndex int, addVal int) {
+		if len(data) > 3000 {
+			t.Skip()
+		}
+		ints := bytesToInts(data, 300)
+		if len(ints) == 0 {
+			t.Skip()
+		}
+		if afterIndex < 0 {
+			afterIndex = -afterIndex
+		}
+		afterIndex %= len(ints)
+
+		var list LinkedList
+		handles := make([]*Node, 0, len(ints))
+		for _, v := range ints {
+			handles = append(handles, list.AddInTail(Node{value: v}))
+		}
+
+		added, err := list.InsertAfter(handles[afterIndex], Node{value: addVal})
+		if err != nil {
+			t.Fatalf("InsertAfter with own handle failed: %v", err)
+		}
+		mustValidate(t, &list, "InsertAfter")
+		if handles[afterIndex].next != added || added.value != addVal {
+			t.Fatalf("InsertAfter did not attach after the given node")
+		}
+
+		want := make([]int, 0, len(ints)+1)
+		want = append(want, ints[:afterIndex+1]...)
+		want = append(want, addVal)
+		want = append(want, ints[afterIndex+1:]...)
+
+		got := listToSlice(&list, len(want)+1)
+		if !slices.Equal(got, want) {
+			t.Fatalf("InsertAfter mismatch: got=%v want=%v afterIndex=%d ints=%v", got, want, afterIndex, ints)
+		}
+		if list.tail.next != nil {
+			t.Fatalf("after InsertAfter tail.next must be nil")
+		}
+		if afterIndex == len(ints)-1 && list.tail != added {
+			t.Fatalf("InsertAfter tail must move to the added node")
+		}
+
+		if err := list.Remove(added); err != nil {
+			t.Fatalf("Remove of returned handle failed: %v", err)
+		}
+		mustValidate(t, &list, "Remove")
+		if got := listToSlice(&list, len(ints)+1); !slices.Equal(got, ints) {
+			t.Fatalf("Remove mismatch: got=%v want=%v", got, ints)
+		}
+		if err := list.Remove(added); err == nil {
+			t.Fatalf("Remove of stale handle must fail")
+		}
+	})
+}
+
+func FuzzLinkedList_DeleteMany(f *testing.F) {
+	f.Add(intsToBytes([]int{}), intsToBytes([]int{1}))
+	f.Add(intsToBytes([]int{7, 7}), intsToBytes([]int{7, 7}))
+	f.Add(intsToBytes([]int{1, 2, 3, 2, 1}), intsToBytes([]int{1, 3, 1}))
+
+	f.Fuzz(func(t *testing.T, data []byte, deletes []byte) {
+		if len(data) > 3000 || len(deletes) > 3000 {
+			t.Skip()
+		}
+		ints := bytesToInts(data, 300)
+		list := GetLinkedList(ints)
+
+		want := slices.Clone(ints)
+		for i, target := range bytesToInts(deletes, 100) {
+			all := i%2 == 1
+			list.Delete(target, all)
+			mustValidate(t, list, "Delete")
+
+			if all {
+				want = slices.DeleteFunc(want, func(v int) bool { return v == target })
+			} else if indx := slices.Index(want, target); indx >= 0 {
+				want = slices.Delete(want, indx, indx+1)
+			}
+			if got := list.Count(); got != len(want) {
+				t.Fatalf("Count mismatch after Delete(%d, %v): got=%d want=%d", target, all, got, len(want))
+			}
+		}
+		if got := listToSlice(list, len(want)+1); !slices.Equal(got, want) {
+			t.Fatalf("Delete mismatch: got=%v want=%v", got, want)
+		}
+	})
+}
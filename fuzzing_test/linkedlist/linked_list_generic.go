@@ -0,0 +1,400 @@
+package linkedlist
+
+import (
+	"iter"
+)
+
+type ListNode[T any] struct {
+	next  *ListNode[T]
+	value T
+	owner *listOwner // record of owner list, nil after removing
+	// set by removing, so a stale handle is not taken for a node built by hand
+	released bool
+}
+
+// listOwner is a record of list identity, which nodes point to.
+// Concat and SpliceAfter link the record of the moved chain
+// to the record of the new list, so ownership moves in O(1),
+// and a node finds its list by root of records, as in union-find
+type listOwner struct {
+	parent *listOwner // nil for the record of a list
+}
+
+// root halves the path on the way, so chains of records stay short
+func (o *listOwner) root() *listOwner {
+	for o.parent != nil {
+		if o.parent.parent != nil {
+			o.parent = o.parent.parent
+		}
+		o = o.parent
+	}
+	return o
+}
+
+// find is root without path halving, it writes nothing,
+// so read-only methods are safe for concurrent use
+func (o *listOwner) find() *listOwner {
+	for o.parent != nil {
+		o = o.parent
+	}
+	return o
+}
+
+func NewListNode[T any](value T) ListNode[T] {
+	return ListNode[T]{value: value}
+}
+
+func (n *ListNode[T]) Value() T {
+	return n.value
+}
+
+// builtByHand reports whether node was never in a list, like &Node{value: x}
+func (n *ListNode[T]) builtByHand() bool {
+	return n.owner == nil && !n.released
+}
+
+// List is a singly linked list of any values,
+// value matching methods use the equal function passed to the constructor
+type List[T any] struct {
+	head   *ListNode[T]
+	tail   *ListNode[T]
+	length int // kept by every mutator, so Count is O(1)
+	equal  func(a, b T) bool
+	pool   *nodePool[T] // nil without WithNodePool
+	owner  *listOwner   // created with the first node, always a root record
+}
+
+// ListOption configures list in constructors
+type ListOption[T any] func(l *List[T])
+
+func NewList[T comparable](opts ...ListOption[T]) *List[T] {
+	return NewListFunc(func(a, b T) bool {
+		return a == b
+	}, opts...)
+}
+
+func NewListFunc[T any](equal func(a, b T) bool, opts ...ListOption[T]) *List[T] {
+	l := &List[T]{equal: equal}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// cmp - function in style of cmp.Compare, where 0 means equal values
+func NewListCmp[T any](cmp func(a, b T) int, opts ...ListOption[T]) *List[T] {
+	return NewListFunc(func(a, b T) bool {
+		return cmp(a, b) == 0
+	}, opts...)
+}
+
+// owns reports whether node is a node of this list,
+// it shortens the record chain of node, so it writes to node and records
+// t = O(1) amortized
+func (l *List[T]) owns(node *ListNode[T]) bool {
+	if node == nil || node.owner == nil || l.owner == nil {
+		return false
+	}
+	node.owner = node.owner.root()
+	return node.owner == l.owner
+}
+
+// ownsReadOnly is owns without compression of records, for Validate and WriteDOT
+// t = O(r), where r = length of record chain
+func (l *List[T]) ownsReadOnly(node *ListNode[T]) bool {
+	if node == nil || node.owner == nil || l.owner == nil {
+		return false
+	}
+	return node.owner.find() == l.owner
+}
+
+func (l *List[T]) ownerRecord() *listOwner {
+	if l.owner == nil {
+		l.owner = &listOwner{}
+	}
+	return l.owner
+}
+
+// zero value list without equal function compares values as interfaces,
+// so it panics for not comparable types
+func (l *List[T]) matches(n T) func(T) bool {
+	if l.equal == nil {
+		return func(value T) bool {
+			return any(value) == any(n)
+		}
+	}
+	return func(value T) bool {
+		return l.equal(value, n)
+	}
+}
+
+// t = O(1)
+func (l *List[T]) AddInTail(item ListNode[T]) *ListNode[T] {
+	node := l.newNode(item.value)
+	if l.head == nil {
+		l.head = node
+	} else {
+		l.tail.next = node
+	}
+	l.tail = node
+	l.length++
+	return node
+}
+
+// t = O(1)
+func (l *List[T]) InsertFirst(first ListNode[T]) *ListNode[T] {
+	node := l.newNode(first.value)
+	if l.head == nil {
+		l.tail = node
+	} else {
+		node.next = l.head
+	}
+	l.head = node
+	l.length++
+	return node
+}
+
+// t = O(1)
+func (l *List[T]) Count() int {
+	return l.length
+}
+
+// returns handle of the first matching node, not a copy
+func (l *List[T]) Find(n T) (*ListNode[T], error) {
+	return l.FindFunc(l.matches(n))
+}
+
+func (l *List[T]) FindFunc(match func(T) bool) (*ListNode[T], error) {
+	tempNode := l.head
+	for tempNode != nil {
+		if match(tempNode.value) {
+			return tempNode, nil
+		}
+		tempNode = tempNode.next
+	}
+	return nil, ErrNotFound
+}
+
+// t = O(n), where n = len(list)
+func (l *List[T]) FindAll(n T) []*ListNode[T] {
+	return l.FindAllFunc(l.matches(n))
+}
+
+func (l *List[T]) FindAllFunc(match func(T) bool) []*ListNode[T] {
+	var nodes []*ListNode[T]
+	tempNode := l.head
+	for tempNode != nil {
+		if match(tempNode.value) {
+			nodes = append(nodes, tempNode)
+		}
+		tempNode = tempNode.next
+	}
+	return nodes
+}
+
+// t = O(n), where n = len(list)
+func (l *List[T]) Delete(n T, all bool) {
+	l.DeleteFunc(l.matches(n), all)
+}
+
+func (l *List[T]) DeleteFunc(match func(T) bool, all bool) {
+	var prev *ListNode[T]
+	tempNode := l.head
+	for tempNode != nil {
+		next := tempNode.next
+		if !match(tempNode.value) {
+			prev = tempNode
+			tempNode = next
+			continue
+		}
+
+		if prev == nil {
+			l.head = next
+		} else {
+			prev.next = next
+		}
+		if tempNode == l.tail {
+			l.tail = prev
+		}
+		// old handles of deleted nodes are stale now
+		l.release(tempNode)
+		l.length--
+
+		if !all {
+			return
+		}
+		tempNode = next
+	}
+}
+
+// handle of this list is used as is, node of other list and removed node
+// are rejected, node built by hand is matched by value for the old api,
+// empty list gets add as head whatever after is, as the old api did
+// t = O(n), where n = len(list)
+func (l *List[T]) Insert(after *ListNode[T], add ListNode[T]) (*ListNode[T], error) {
+	if l.head == nil {
+		return l.InsertFirst(add), nil
+	}
+	if after == nil || !after.builtByHand() {
+		return l.InsertAfter(after, add)
+	}
+	return l.InsertFunc(l.matches(after.value), add)
+}
+
+// inserts add after the first node which value matches
+func (l *List[T]) InsertFunc(match func(T) bool, add ListNode[T]) (*ListNode[T], error) {
+	if l.head == nil {
+		return l.InsertFirst(add), nil
+	}
+	tempNode := l.head
+	for tempNode != nil && !match(tempNode.value) {
+		tempNode = tempNode.next
+	}
+	if tempNode == nil {
+		return nil, ErrNotFound
+	}
+	return l.InsertAfter(tempNode, add)
+}
+
+// t = O(1)
+func (l *List[T]) InsertAfter(after *ListNode[T], add ListNode[T]) (*ListNode[T], error) {
+	if !l.owns(after) {
+		return nil, ErrForeignNode
+	}
+	if after == l.tail {
+		return l.AddInTail(add), nil
+	}
+	node := l.newNode(add.value)
+	node.next = after.next
+	after.next = node
+	l.length++
+	return node, nil
+}
+
+// t = O(n), where n = len(list), singly list has to find prev node
+func (l *List[T]) InsertBefore(before *ListNode[T], add ListNode[T]) (*ListNode[T], error) {
+	if !l.owns(before) {
+		return nil, ErrForeignNode
+	}
+	if before == l.head {
+		return l.InsertFirst(add), nil
+	}
+	return l.InsertAfter(l.prevOf(before), add)
+}
+
+// t = O(n), where n = len(list), singly list has to find prev node
+func (l *List[T]) Remove(node *ListNode[T]) error {
+	if !l.owns(node) {
+		return ErrForeignNode
+	}
+	prev := l.prevOf(node)
+	if prev == nil {
+		l.head = node.next
+	} else {
+		prev.next = node.next
+	}
+	if node == l.tail {
+		l.tail = prev
+	}
+	l.release(node)
+	l.length--
+	return nil
+}
+
+// t = O(1)
+func (l *List[T]) PopFront() (T, bool) {
+	if l.head == nil {
+		var zero T
+		return zero, false
+	}
+	value := l.head.value
+	l.Remove(l.head)
+	return value, true
+}
+
+// node must be from this list, nil for head
+func (l *List[T]) prevOf(node *ListNode[T]) *ListNode[T] {
+	var prev *ListNode[T]
+	for tempNode := l.head; tempNode != node; tempNode = tempNode.next {
+		prev = tempNode
+	}
+	return prev
+}
+
+// t = O(n), where n = len(list)
+func (l *List[T]) Clean() {
+	// detach nodes, so old handles can not change anything
+	for tempNode := l.head; tempNode != nil; {
+		next := tempNode.next
+		l.release(tempNode)
+		tempNode = next
+	}
+	l.head = nil
+	l.tail = nil
+	l.length = 0
+}
+
+func GetList[T comparable](values []T) *List[T] {
+	return GetListFunc(values, func(a, b T) bool {
+		return a == b
+	})
+}
+
+// GetListFunc is GetList for values, which are not comparable by ==
+func GetListFunc[T any](values []T, equal func(a, b T) bool) *List[T] {
+	resultL := NewListFunc(equal) // resulting linked list
+	for _, value := range values {
+		resultL.AddInTail(ListNode[T]{
+			value: value,
+		})
+	}
+	return resultL
+}
+
+// FromSeq builds list from any iterator, e.g. slices.Values or maps.Keys
+func FromSeq[T comparable](seq iter.Seq[T]) *List[T] {
+	return FromSeqFunc(seq, func(a, b T) bool {
+		return a == b
+	})
+}
+
+// FromSeqFunc is FromSeq for values, which are not comparable by ==
+func FromSeqFunc[T any](seq iter.Seq[T], equal func(a, b T) bool) *List[T] {
+	resultL := NewListFunc(equal) // resulting linked list
+	for value := range seq {
+		resultL.AddInTail(ListNode[T]{
+			value: value,
+		})
+	}
+	return resultL
+}
+
+// All iterates values from head to tail,
+// singly list has no prev links, so reverse traversal is only in DoublyList
+func (l *List[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for tempNode := l.head; tempNode != nil; tempNode = tempNode.next {
+			if !yield(tempNode.value) {
+				return
+			}
+		}
+	}
+}
+
+// Values is the same as All, in style of slices.Values
+func (l *List[T]) Values() iter.Seq[T] {
+	return l.All()
+}
+
+// Enumerate iterates pairs of position and value, in style of slices.All
+func (l *List[T]) Enumerate() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		indx := 0
+		for tempNode := l.head; tempNode != nil; tempNode = tempNode.next {
+			if !yield(indx, tempNode.value) {
+				return
+			}
+			indx++
+		}
+	}
+}
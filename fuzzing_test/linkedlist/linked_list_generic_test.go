@@ -0,0 +1,242 @@
+package linkedlist
+
+import (
+	"cmp"
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+)
+
+type user struct {
+	id   int
+	name string
+}
+
+func genericToSlice[T any](l *List[T]) []T {
+	return slices.Collect(l.All())
+}
+
+func TestListStrings(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []string
+		delete string
+		all    bool
+		want   []string
+	}{
+		{"Test1: ", []string{}, "a", false, []string{}},
+		{"Test2: ", []string{"a", "b", "a", "c"}, "a", false, []string{"b", "a", "c"}},
+		{"Test3: ", []string{"a", "b", "a", "c"}, "a", true, []string{"b", "c"}},
+		{"Test4: ", []string{"a"}, "a", false, []string{}},
+	}
+
+	for _, test := range tests {
+		list := NewList[string]()
+		for _, value := range test.input {
+			list.AddInTail(ListNode[string]{value: value})
+		}
+		list.Delete(test.delete, test.all)
+
+		if got := genericToSlice(list); !slices.Equal(got, test.want) {
+			t.Errorf("failed %s: delete %q, got %v, want %v", test.name, test.delete, got, test.want)
+		}
+		if list.Count() != len(test.want) {
+			t.Errorf("failed %s: wrong count list", test.name)
+		}
+	}
+}
+
+func TestListCmpFind(t *testing.T) {
+	list := NewListCmp(func(a, b string) int {
+		return cmp.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	for _, value := range []string{"Go", "Rust", "go"} {
+		list.AddInTail(ListNode[string]{value: value})
+	}
+
+	node, err := list.Find("GO")
+	if err != nil || node.Value() != "Go" {
+		t.Errorf("failed: find case insensitive, got %v, err %v", node, err)
+	}
+	if got := list.FindAll("go"); len(got) != 2 {
+		t.Errorf("failed: find all case insensitive, got %d nodes", len(got))
+	}
+	if _, err := list.Find("python"); err == nil {
+		t.Errorf("failed: find missing value without error")
+	}
+}
+
+func TestListFuncStructs(t *testing.T) {
+	list := NewListFunc(func(a, b user) bool {
+		return a.id == b.id
+	})
+	list.AddInTail(ListNode[user]{value: user{1, "ann"}})
+	list.AddInTail(ListNode[user]{value: user{2, "bob"}})
+	list.InsertFirst(ListNode[user]{value: user{0, "root"}})
+	list.Insert(&ListNode[user]{value: user{id: 1}}, ListNode[user]{value: user{3, "kate"}})
+
+	want := []user{{0, "root"}, {1, "ann"}, {3, "kate"}, {2, "bob"}}
+	if got := genericToSlice(list); !slices.Equal(got, want) {
+		t.Errorf("failed: insert structs, got %v, want %v", got, want)
+	}
+
+	node, err := list.Find(user{id: 2})
+	if err != nil || node.Value().name != "bob" {
+		t.Errorf("failed: find struct by id, got %v, err %v", node, err)
+	}
+
+	list.Clean()
+	if list.head != nil || list.tail != nil || list.Count() != 0 {
+		t.Errorf("failed: clean list")
+	}
+}
+
+func TestGetList(t *testing.T) {
+	list := GetList([]string{"a", "b", "c"})
+	if got := genericToSlice(list); !slices.Equal(got, []string{"a", "b", "c"}) {
+		t.Errorf("failed: get list, got %v", got)
+	}
+	if node, err := list.Find("b"); err != nil || node.Value() != "b" {
+		t.Errorf("failed: find in list of comparable values, got %v, err %v", node, err)
+	}
+
+	slicesList := GetListFunc([][]int{{1}, {2, 3}}, slices.Equal[[]int])
+	if node, err := slicesList.Find([]int{2, 3}); err != nil || !slices.Equal(node.Value(), []int{2, 3}) {
+		t.Errorf("failed: find in list of slices, got %v, err %v", node, err)
+	}
+}
+
+func TestFromSeq(t *testing.T) {
+	ids := map[int]string{1: "ann", 2: "bob"}
+	list := FromSeq(maps.Keys(ids))
+	if got := slices.Sorted(list.All()); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("failed: from map keys, got %v", got)
+	}
+
+	if node, err := list.Find(2); err != nil || node.Value() != 2 {
+		t.Errorf("failed: find in list from map keys, got %v, err %v", node, err)
+	}
+
+	slicesList := FromSeqFunc(slices.Values([][]int{{1}, {2, 3}}), slices.Equal[[]int])
+	if node, err := slicesList.Find([]int{1}); err != nil || !slices.Equal(node.Value(), []int{1}) {
+		t.Errorf("failed: find in list of slices, got %v, err %v", node, err)
+	}
+
+	for indx, value := range GetList([]string{"a", "b"}).Enumerate() {
+		if want := []string{"a", "b"}[indx]; value != want {
+			t.Errorf("failed: enumerate at %d, got %q, want %q", indx, value, want)
+		}
+	}
+}
+
+func TestListHandles(t *testing.T) {
+	list := NewList[int]()
+	first := list.AddInTail(NewListNode(7))
+	second := list.AddInTail(NewListNode(7))
+	list.InsertFirst(NewListNode(1))
+
+	// both nodes have equal values, so only handle can point the second one
+	if _, err := list.InsertAfter(second, NewListNode(8)); err != nil {
+		t.Fatalf("failed: insert after handle, err %v", err)
+	}
+	if _, err := list.InsertBefore(first, NewListNode(6)); err != nil {
+		t.Fatalf("failed: insert before handle, err %v", err)
+	}
+	if _, err := list.Insert(first, NewListNode(0)); err != nil {
+		t.Fatalf("failed: insert with handle, err %v", err)
+	}
+	if got, want := genericToSlice(list), []int{1, 6, 7, 0, 7, 8}; !slices.Equal(got, want) {
+		t.Errorf("failed: insert by handles, got %v, want %v", got, want)
+	}
+
+	found, err := list.Find(7)
+	if err != nil || found != first {
+		t.Errorf("failed: find must return handle of the first node, err %v", err)
+	}
+	if nodes := list.FindAll(7); len(nodes) != 2 || nodes[1] != second {
+		t.Errorf("failed: find all must return handles")
+	}
+
+	if err := list.Remove(second); err != nil {
+		t.Fatalf("failed: remove handle, err %v", err)
+	}
+	if err := list.Remove(list.tail); err != nil {
+		t.Fatalf("failed: remove tail, err %v", err)
+	}
+	if got, want := genericToSlice(list), []int{1, 6, 7, 0}; !slices.Equal(got, want) {
+		t.Errorf("failed: remove by handles, got %v, want %v", got, want)
+	}
+	if list.tail.Value() != 0 || list.tail.next != nil {
+		t.Errorf("failed: tail after remove")
+	}
+}
+
+func TestListStaleHandles(t *testing.T) {
+	list, other := GetList([]int{1, 2, 3}), GetList([]int{1, 2, 3})
+	foreign, _ := other.Find(2)
+	removed, _ := list.Find(3)
+	if err := list.Remove(removed); err != nil {
+		t.Fatalf("failed: remove handle, err %v", err)
+	}
+	deleted, _ := list.Find(1)
+	list.Delete(1, false)
+
+	for _, node := range []*ListNode[int]{nil, foreign, removed, deleted, {value: 2}} {
+		if _, err := list.InsertAfter(node, NewListNode(9)); !errors.Is(err, ErrForeignNode) {
+			t.Errorf("failed: insert after stale node, err %v", err)
+		}
+		if _, err := list.InsertBefore(node, NewListNode(9)); !errors.Is(err, ErrForeignNode) {
+			t.Errorf("failed: insert before stale node, err %v", err)
+		}
+		if err := list.Remove(node); !errors.Is(err, ErrForeignNode) {
+			t.Errorf("failed: remove stale node, err %v", err)
+		}
+	}
+	for _, node := range []*ListNode[int]{foreign, removed, deleted} {
+		if _, err := list.Insert(node, NewListNode(9)); !errors.Is(err, ErrForeignNode) {
+			t.Errorf("failed: insert after stale node, err %v", err)
+		}
+	}
+	if got := genericToSlice(list); !slices.Equal(got, []int{2}) {
+		t.Errorf("failed: stale insert changed list, got %v", got)
+	}
+	if _, err := list.Insert(&ListNode[int]{value: 2}, NewListNode(9)); err != nil {
+		t.Errorf("failed: insert after node built by hand, err %v", err)
+	}
+	if _, err := list.Insert(&ListNode[int]{value: 10}, NewListNode(9)); err == nil {
+		t.Errorf("failed: insert after missing value without error")
+	}
+
+	kept, _ := list.Find(2)
+	list.Clean()
+	if err := list.Remove(kept); !errors.Is(err, ErrForeignNode) {
+		t.Errorf("failed: remove node after clean, err %v", err)
+	}
+	if got := genericToSlice(list); len(got) != 0 {
+		t.Errorf("failed: stale handles changed list, got %v", got)
+	}
+	// empty list takes the node as head whatever the handle is, as the old api did
+	if _, err := list.Insert(kept, NewListNode(9)); err != nil {
+		t.Errorf("failed: insert into empty list, err %v", err)
+	}
+	if got := genericToSlice(list); !slices.Equal(got, []int{9}) {
+		t.Errorf("failed: insert into empty list, got %v", got)
+	}
+}
+
+func TestListPopFront(t *testing.T) {
+	list := GetList([]int{1, 2})
+	for _, want := range []int{1, 2} {
+		if value, ok := list.PopFront(); !ok || value != want {
+			t.Errorf("failed: pop front, got %d %v, want %d", value, ok, want)
+		}
+	}
+	if _, ok := list.PopFront(); ok {
+		t.Errorf("failed: pop front from empty list")
+	}
+	if err := list.Validate(); err != nil {
+		t.Errorf("failed: list after pop front, err %v", err)
+	}
+}
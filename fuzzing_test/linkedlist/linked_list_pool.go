@@ -0,0 +1,71 @@
+package linkedlist
+
+// nodes are allocated by chunks, so growing pooled list
+// allocates once per nodePoolChunk nodes, or per limit nodes if it is less
+const nodePoolChunk = 64
+
+// nodePool is a free list of released nodes linked by next
+type nodePool[T any] struct {
+	free  *ListNode[T]
+	count int
+	limit int
+}
+
+// WithNodePool reuses nodes released by Delete, Remove, PopFront and Clean
+// for next insertions, and keeps up to limit free nodes, limit <= 0 means no limit.
+// Released node may become a node of the list again,
+// so handles of removed nodes must not be kept in this mode
+func WithNodePool[T any](limit int) ListOption[T] {
+	return func(l *List[T]) {
+		l.pool = &nodePool[T]{limit: limit}
+	}
+}
+
+// NewLinkedList is for LinkedList with options, zero value LinkedList works without them
+func NewLinkedList(opts ...ListOption[int]) *LinkedList {
+	var resultLL LinkedList
+	for _, opt := range opts {
+		opt(&resultLL.List)
+	}
+	return &resultLL
+}
+
+func (l *List[T]) newNode(value T) *ListNode[T] {
+	if l.pool == nil {
+		return &ListNode[T]{value: value, owner: l.ownerRecord()}
+	}
+	if l.pool.free == nil {
+		size := nodePoolChunk
+		if l.pool.limit > 0 {
+			// free nodes of a chunk are kept by the pool, so it is not more than limit
+			size = min(size, l.pool.limit)
+		}
+		chunk := make([]ListNode[T], size)
+		for i := range chunk {
+			l.pool.put(&chunk[i])
+		}
+	}
+	node := l.pool.free
+	l.pool.free = node.next
+	l.pool.count--
+	node.next, node.value, node.owner, node.released = nil, value, l.ownerRecord(), false
+	return node
+}
+
+// release detaches removed node, so old handles can not change anything
+func (l *List[T]) release(node *ListNode[T]) {
+	node.next, node.owner, node.released = nil, nil, true
+	if l.pool == nil || l.pool.limit > 0 && l.pool.count >= l.pool.limit {
+		return
+	}
+	// value is dropped, so pool does not keep it from GC
+	var zero T
+	node.value = zero
+	l.pool.put(node)
+}
+
+func (p *nodePool[T]) put(node *ListNode[T]) {
+	node.next = p.free
+	p.free = node
+	p.count++
+}
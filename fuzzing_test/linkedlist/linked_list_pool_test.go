@@ -0,0 +1,144 @@
+package linkedlist
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestNodePoolReuse(t *testing.T) {
+	list := NewLinkedList(WithNodePool[int](0))
+	first := list.AddInTail(Node{value: 1})
+	list.AddInTail(Node{value: 2})
+
+	list.Delete(1, false)
+	if first.owner != nil || !first.released || first.value != 0 {
+		t.Errorf("failed: released node must be detached and cleared")
+	}
+	// released node is stale, not a node built by hand with zero value
+	if _, err := list.Insert(first, Node{value: 4}); !errors.Is(err, ErrForeignNode) {
+		t.Errorf("failed: insert after released node, err %v", err)
+	}
+	if reused := list.InsertFirst(Node{value: 3}); reused != first {
+		t.Errorf("failed: released node is not reused")
+	}
+
+	list.Clean()
+	for value := range 3 {
+		list.AddInTail(Node{value: value})
+	}
+	if got := slices.Collect(list.All()); !slices.Equal(got, []int{0, 1, 2}) {
+		t.Errorf("failed: list on reused nodes, got %v", got)
+	}
+	if err := list.Validate(); err != nil {
+		t.Errorf("failed: pooled list, err %v", err)
+	}
+}
+
+func TestNodePoolLimit(t *testing.T) {
+	list := NewListFunc(func(a, b string) bool { return a == b }, WithNodePool[string](2))
+	list.AddInTail(NewListNode("a"))
+	if list.pool.count > 2 {
+		t.Errorf("failed: pool keeps %d free nodes after the first insertion, limit 2", list.pool.count)
+	}
+	for range nodePoolChunk {
+		list.AddInTail(NewListNode("a"))
+		if list.pool.count > 2 {
+			t.Fatalf("failed: pool keeps %d free nodes, limit 2", list.pool.count)
+		}
+	}
+	list.Clean()
+	if list.pool.count != 2 {
+		t.Errorf("failed: pool keeps %d free nodes, want 2", list.pool.count)
+	}
+}
+
+func FuzzLinkedList_Pooled(f *testing.F) {
+	f.Add(opsToBytes([]stateOp{{stAddInTail, 1}, {stDelete, 1}, {stInsertFirst, 2}, {stRemove, 0}}))
+	f.Add(opsToBytes([]stateOp{{stAddInTail, 1}, {stAddInTail, 2}, {stClean, 0}, {stAddInTail, 3}, {stInsert, 0}}))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if len(data) > 3000 {
+			t.Skip()
+		}
+		ops := bytesToOps(data, 500)
+		run := func(ops []stateOp) (int, string) {
+			return runStateOpsOn(NewLinkedList(WithNodePool[int](4)), ops)
+		}
+		if failed, reason := run(ops); failed >= 0 {
+			minimal := minimizeStateOps(ops, run)
+			t.Fatalf("step %d failed: %s\nminimized trace:%s", failed, reason, formatStateOps(minimal))
+		}
+	})
+}
+
+// every mutator without and with pool, allocs/op is the point
+
+func benchmarkListModes(b *testing.B, bench func(b *testing.B, newList func() *LinkedList)) {
+	b.Run("default", func(b *testing.B) {
+		bench(b, func() *LinkedList { return NewLinkedList() })
+	})
+	b.Run("pooled", func(b *testing.B) {
+		bench(b, func() *LinkedList { return NewLinkedList(WithNodePool[int](0)) })
+	})
+}
+
+func BenchmarkAddInTailChurn(b *testing.B) {
+	benchmarkListModes(b, func(b *testing.B, newList func() *LinkedList) {
+		list := newList()
+		b.ReportAllocs()
+		for b.Loop() {
+			list.AddInTail(Node{value: 1})
+			list.PopFront()
+		}
+	})
+}
+
+func BenchmarkInsertFirstChurn(b *testing.B) {
+	benchmarkListModes(b, func(b *testing.B, newList func() *LinkedList) {
+		list := newList()
+		list.AddInTail(Node{value: 0})
+		b.ReportAllocs()
+		for b.Loop() {
+			list.InsertFirst(Node{value: 1})
+			list.Delete(1, false)
+		}
+	})
+}
+
+func BenchmarkInsertAfterChurn(b *testing.B) {
+	benchmarkListModes(b, func(b *testing.B, newList func() *LinkedList) {
+		list := newList()
+		head := list.AddInTail(Node{value: 0})
+		b.ReportAllocs()
+		for b.Loop() {
+			node, _ := list.InsertAfter(head, Node{value: 1})
+			list.Remove(node)
+		}
+	})
+}
+
+func BenchmarkCleanRefill(b *testing.B) {
+	benchmarkListModes(b, func(b *testing.B, newList func() *LinkedList) {
+		list := newList()
+		b.ReportAllocs()
+		for b.Loop() {
+			for value := range 100 {
+				list.AddInTail(Node{value: value})
+			}
+			list.Clean()
+		}
+	})
+}
+
+func BenchmarkGrow(b *testing.B) {
+	benchmarkListModes(b, func(b *testing.B, newList func() *LinkedList) {
+		b.ReportAllocs()
+		for b.Loop() {
+			list := newList()
+			for value := range 1000 {
+				list.AddInTail(Node{value: value})
+			}
+		}
+	})
+}
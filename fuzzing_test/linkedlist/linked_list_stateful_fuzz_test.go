@@ -0,0 +1,251 @@
+package linkedlist
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+type stateOpKind byte
+
+const (
+	stAddInTail stateOpKind = iota
+	stInsertFirst
+	stInsert      // Insert after handle of node at arg position
+	stInsertValue // Insert after detached node with arg value, old api
+	stInsertBefore
+	stDelete
+	stDeleteAll
+	stRemove
+	stPopFront
+	stClean
+	stFind
+	stFindAll
+	stOpCount
+)
+
+// stateOp is one step of the stateful fuzzer,
+// arg is a value or a position depending on kind
+type stateOp struct {
+	kind stateOpKind
+	arg  int
+}
+
+func (op stateOp) String() string {
+	names := [...]string{
+		"AddInTail", "InsertFirst", "Insert", "InsertValue", "InsertBefore", "Delete",
+		"DeleteAll", "Remove", "PopFront", "Clean", "Find", "FindAll",
+	}
+	return fmt.Sprintf("%s(%d)", names[op.kind], op.arg)
+}
+
+// every op is 2 bytes: kind and arg, arg is small,
+// so values repeat and deletes hit existing nodes
+func bytesToOps(b []byte, maxN int) []stateOp {
+	ops := make([]stateOp, 0, min(len(b)/2, maxN))
+	for i := 0; i+1 < len(b) && len(ops) < maxN; i += 2 {
+		ops = append(ops, stateOp{
+			kind: stateOpKind(b[i] % byte(stOpCount)),
+			arg:  int(b[i+1] % 8),
+		})
+	}
+	return ops
+}
+
+func opsToBytes(ops []stateOp) []byte {
+	out := make([]byte, 0, 2*len(ops))
+	for _, op := range ops {
+		out = append(out, byte(op.kind), byte(op.arg))
+	}
+	return out
+}
+
+// runStateOps applies ops to LinkedList and []int model,
+// returns index of the first failed step and reason, or -1
+func runStateOps(ops []stateOp) (failed int, reason string) {
+	return runStateOpsOn(&LinkedList{}, ops)
+}
+
+func runStateOpsOn(list *LinkedList, ops []stateOp) (failed int, reason string) {
+	model := []int{}
+	step := 0
+
+	defer func() {
+		if r := recover(); r != nil {
+			failed, reason = step, fmt.Sprintf("panic: %v", r)
+		}
+	}()
+
+	// handle of node at position of the model
+	nodeAt := func(pos int) *Node {
+		n := list.head
+		for range pos {
+			n = n.next
+		}
+		return n
+	}
+
+	for ; step < len(ops); step++ {
+		op := ops[step]
+		switch op.kind {
+		case stAddInTail:
+			list.AddInTail(Node{value: op.arg})
+			model = append(model, op.arg)
+		case stInsertFirst:
+			list.InsertFirst(Node{value: op.arg})
+			model = slices.Insert(model, 0, op.arg)
+		case stInsert, stInsertBefore, stRemove:
+			if len(model) == 0 {
+				continue
+			}
+			pos := op.arg % len(model)
+			node := nodeAt(pos)
+			var err error
+			switch op.kind {
+			case stInsert:
+				_, err = list.Insert(node, Node{value: op.arg})
+				model = slices.Insert(model, pos+1, op.arg)
+			case stInsertBefore:
+				_, err = list.InsertBefore(node, Node{value: op.arg})
+				model = slices.Insert(model, pos, op.arg)
+			case stRemove:
+				err = list.Remove(node)
+				model = slices.Delete(model, pos, pos+1)
+			}
+			if err != nil {
+				return step, fmt.Sprintf("handle at %d rejected: %v", pos, err)
+			}
+		case stInsertValue:
+			_, err := list.Insert(&Node{value: op.arg}, Node{value: -op.arg})
+			pos := slices.Index(model, op.arg)
+			switch {
+			case len(model) == 0:
+				model = append(model, -op.arg)
+			case pos >= 0:
+				model = slices.Insert(model, pos+1, -op.arg)
+			case err == nil:
+				return step, "insert after missing value without error"
+			}
+		case stDelete:
+			list.Delete(op.arg, false)
+			if pos := slices.Index(model, op.arg); pos >= 0 {
+				model = slices.Delete(model, pos, pos+1)
+			}
+		case stDeleteAll:
+			list.Delete(op.arg, true)
+			model = slices.DeleteFunc(model, func(v int) bool { return v == op.arg })
+		case stPopFront:
+			value, ok := list.PopFront()
+			if ok != (len(model) > 0) || ok && value != model[0] {
+				return step, fmt.Sprintf("PopFront got %d %v", value, ok)
+			}
+			if ok {
+				model = model[1:]
+			}
+		case stClean:
+			list.Clean()
+			model = model[:0]
+		case stFind:
+			node, err := list.Find(op.arg)
+			if want := slices.Index(model, op.arg) >= 0; want != (err == nil) || want && node.value != op.arg {
+				return step, fmt.Sprintf("Find got %v, err %v", node, err)
+			}
+		case stFindAll:
+			want := 0
+			for _, v := range model {
+				if v == op.arg {
+					want++
+				}
+			}
+			if got := len(list.FindAll(op.arg)); got != want {
+				return step, fmt.Sprintf("FindAll got %d nodes, want %d", got, want)
+			}
+		}
+
+		if err := list.Validate(); err != nil {
+			return step, err.Error()
+		}
+		if got := listToSlice(list, len(model)+1); !slices.Equal(got, model) {
+			return step, fmt.Sprintf("list %v, model %v", got, model)
+		}
+	}
+	return -1, ""
+}
+
+// minimizeStateOps removes ops one by one while run still fails,
+// run is runStateOps except tests of the minimizer
+func minimizeStateOps(ops []stateOp, run func([]stateOp) (int, string)) []stateOp {
+	ops = slices.Clone(ops)
+	if failed, _ := run(ops); failed >= 0 {
+		ops = ops[:failed+1]
+	}
+	for changed := true; changed; {
+		changed = false
+		for i := len(ops) - 1; i >= 0; i-- {
+			candidate := slices.Delete(slices.Clone(ops), i, i+1)
+			if failed, _ := run(candidate); failed >= 0 {
+				ops = candidate[:failed+1]
+				changed = true
+				break
+			}
+		}
+	}
+	return ops
+}
+
+func formatStateOps(ops []stateOp) string {
+	var trace strings.Builder
+	for i, op := range ops {
+		fmt.Fprintf(&trace, "\n\t%d: %v", i, op)
+	}
+	return trace.String()
+}
+
+func FuzzLinkedList_Stateful(f *testing.F) {
+	f.Add(opsToBytes([]stateOp{}))
+	f.Add(opsToBytes([]stateOp{{stAddInTail, 1}, {stAddInTail, 2}, {stDelete, 2}, {stInsert, 0}}))
+	f.Add(opsToBytes([]stateOp{{stInsertFirst, 3}, {stDeleteAll, 3}, {stInsertValue, 3}, {stAddInTail, 3}}))
+	f.Add(opsToBytes([]stateOp{{stAddInTail, 5}, {stInsertBefore, 0}, {stRemove, 1}, {stPopFront, 0}, {stFind, 5}}))
+	f.Add(opsToBytes([]stateOp{{stAddInTail, 1}, {stAddInTail, 1}, {stDeleteAll, 1}, {stAddInTail, 2}, {stFindAll, 2}, {stClean, 0}}))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if len(data) > 3000 {
+			t.Skip()
+		}
+		ops := bytesToOps(data, 500)
+
+		failed, reason := runStateOps(ops)
+		if failed < 0 {
+			return
+		}
+		minimal := minimizeStateOps(ops, runStateOps)
+		_, minimalReason := runStateOps(minimal)
+		t.Fatalf("step %d failed: %s\nminimized trace (%s):%s\nreproduce with f.Add(%#v)",
+			failed, reason, minimalReason, formatStateOps(minimal), opsToBytes(minimal))
+	})
+}
+
+func TestMinimizeStateOps(t *testing.T) {
+	ops := []stateOp{{stAddInTail, 1}, {stAddInTail, 2}, {stDelete, 2}, {stInsert, 0}}
+	if failed, reason := runStateOps(ops); failed >= 0 {
+		t.Fatalf("failed: valid ops are broken at %d: %s", failed, reason)
+	}
+
+	// fake bug: Clean after AddInTail(7) fails
+	run := func(ops []stateOp) (int, string) {
+		added := false
+		for i, op := range ops {
+			added = added || op == stateOp{stAddInTail, 7}
+			if added && op.kind == stClean {
+				return i, "clean after add 7"
+			}
+		}
+		return -1, ""
+	}
+	ops = []stateOp{{stAddInTail, 1}, {stAddInTail, 7}, {stFind, 7}, {stDelete, 1}, {stClean, 0}, {stAddInTail, 2}}
+	want := []stateOp{{stAddInTail, 7}, {stClean, 0}}
+	if got := minimizeStateOps(ops, run); !slices.Equal(got, want) {
+		t.Errorf("failed: minimize ops, got %s", formatStateOps(got))
+	}
+}
@@ -0,0 +1,130 @@
+package linkedlist
+
+import (
+	"math"
+)
+
+// ZipMode sets what element-wise operations do with lists of different lengths
+type ZipMode int
+
+const (
+	// ZipStrict returns empty list and LengthMismatchError
+	ZipStrict ZipMode = iota
+	// ZipPad treats missing values of the shorter list as 0
+	ZipPad
+	// ZipTruncate stops at the end of the shorter list
+	ZipTruncate
+)
+
+// ZipWith applies fn to values of both lists position by position
+// t = O(n), where n - len(list), mem = O(n), where n - len(list)
+func ZipWith(l1 *LinkedList, l2 *LinkedList, mode ZipMode, fn func(a, b int) int) (*LinkedList, error) {
+	return zipChecked(l1, l2, mode, "", func(a, b int) (int, bool) {
+		return fn(a, b), true
+	})
+}
+
+func Add(l1 *LinkedList, l2 *LinkedList, mode ZipMode) (*LinkedList, error) {
+	return ZipWith(l1, l2, mode, func(a, b int) int { return a + b })
+}
+
+func Sub(l1 *LinkedList, l2 *LinkedList, mode ZipMode) (*LinkedList, error) {
+	return ZipWith(l1, l2, mode, func(a, b int) int { return a - b })
+}
+
+func Mul(l1 *LinkedList, l2 *LinkedList, mode ZipMode) (*LinkedList, error) {
+	return ZipWith(l1, l2, mode, func(a, b int) int { return a * b })
+}
+
+// Dot is a sum of products, with ZipPad missing values give 0 products
+func Dot(l1 *LinkedList, l2 *LinkedList, mode ZipMode) (int, error) {
+	products, err := Mul(l1, l2, mode)
+	if err != nil {
+		return 0, err
+	}
+	var sum int
+	for value := range products.All() {
+		sum += value
+	}
+	return sum, nil
+}
+
+// checked variants return empty list and OverflowError with the first overflowed index
+
+func AddChecked(l1 *LinkedList, l2 *LinkedList, mode ZipMode) (*LinkedList, error) {
+	return zipChecked(l1, l2, mode, "add", addInt)
+}
+
+func SubChecked(l1 *LinkedList, l2 *LinkedList, mode ZipMode) (*LinkedList, error) {
+	return zipChecked(l1, l2, mode, "sub", subInt)
+}
+
+func MulChecked(l1 *LinkedList, l2 *LinkedList, mode ZipMode) (*LinkedList, error) {
+	return zipChecked(l1, l2, mode, "mul", mulInt)
+}
+
+// index of error points the product or the partial sum which overflowed
+func DotChecked(l1 *LinkedList, l2 *LinkedList, mode ZipMode) (int, error) {
+	products, err := MulChecked(l1, l2, mode)
+	if err != nil {
+		return 0, err
+	}
+	var sum int
+	for indx, value := range products.Enumerate() {
+		var ok bool
+		if sum, ok = addInt(sum, value); !ok {
+			return 0, &OverflowError{Op: "dot", Index: indx}
+		}
+	}
+	return sum, nil
+}
+
+func zipChecked(l1 *LinkedList, l2 *LinkedList, mode ZipMode, op string, fn func(a, b int) (int, bool)) (*LinkedList, error) {
+	L1Count, L2Count := l1.Count(), l2.Count()
+	if mode == ZipStrict && L1Count != L2Count {
+		return GetLinkedList([]int{}), &LengthMismatchError{Len1: L1Count, Len2: L2Count}
+	}
+
+	var resultLL LinkedList // resulting linked list
+	tempNodeL1, tempNodeL2 := l1.head, l2.head
+	for indx := 0; tempNodeL1 != nil || tempNodeL2 != nil; indx++ {
+		if mode == ZipTruncate && (tempNodeL1 == nil || tempNodeL2 == nil) {
+			break
+		}
+		var a, b int // 0 for padding
+		if tempNodeL1 != nil {
+			a, tempNodeL1 = tempNodeL1.value, tempNodeL1.next
+		}
+		if tempNodeL2 != nil {
+			b, tempNodeL2 = tempNodeL2.value, tempNodeL2.next
+		}
+
+		value, ok := fn(a, b)
+		if !ok {
+			return GetLinkedList([]int{}), &OverflowError{Op: op, Index: indx}
+		}
+		resultLL.AddInTail(Node{value: value})
+	}
+	return &resultLL, nil
+}
+
+func addInt(a, b int) (int, bool) {
+	c := a + b
+	return c, (c > a) == (b > 0)
+}
+
+func subInt(a, b int) (int, bool) {
+	c := a - b
+	return c, (c < a) == (b > 0)
+}
+
+func mulInt(a, b int) (int, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	c := a * b
+	if (a == -1 && b == math.MinInt) || (b == -1 && a == math.MinInt) {
+		return c, false
+	}
+	return c, c/b == a
+}
@@ -0,0 +1,108 @@
+package linkedlist
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestZipModes(t *testing.T) {
+	tests := []struct {
+		name    string
+		inputL1 *LinkedList
+		inputL2 *LinkedList
+		mode    ZipMode
+		err     error
+		want    []int
+	}{
+		{"Test1: ", GetLinkedList([]int{}), GetLinkedList([]int{}), ZipStrict, nil, []int{}},
+		{"Test2: ", GetLinkedList([]int{22, 3, 2}), GetLinkedList([]int{10, 11}), ZipStrict, ErrLengthMismatch, []int{}},
+		{"Test3: ", GetLinkedList([]int{22, 3, 2}), GetLinkedList([]int{10, 11}), ZipPad, nil, []int{12, -8, 2}},
+		{"Test4: ", GetLinkedList([]int{22}), GetLinkedList([]int{10, 11}), ZipPad, nil, []int{12, -11}},
+		{"Test5: ", GetLinkedList([]int{22, 3, 2}), GetLinkedList([]int{10, 11}), ZipTruncate, nil, []int{12, -8}},
+		{"Test6: ", GetLinkedList([]int{}), GetLinkedList([]int{10, 11}), ZipTruncate, nil, []int{}},
+	}
+
+	for _, test := range tests {
+		result, err := Sub(test.inputL1, test.inputL2, test.mode)
+		if !errors.Is(err, test.err) {
+			t.Errorf("failed %s: zip error, got %v, want %v", test.name, err, test.err)
+		}
+		if got := slices.Collect(result.All()); !slices.Equal(got, test.want) {
+			t.Errorf("failed %s: zip values, got %v, want %v", test.name, got, test.want)
+		}
+		if err := result.Validate(); err != nil {
+			t.Errorf("failed %s: zip result, err %v", test.name, err)
+		}
+	}
+}
+
+func TestZipOperations(t *testing.T) {
+	l1, l2 := GetLinkedList([]int{1, 2, 3}), GetLinkedList([]int{4, 5, 6})
+
+	if result, _ := Add(l1, l2, ZipStrict); !EqualLists(result, GetLinkedList([]int{5, 7, 9})) {
+		t.Errorf("failed: add lists")
+	}
+	if result, _ := Mul(l1, l2, ZipStrict); !EqualLists(result, GetLinkedList([]int{4, 10, 18})) {
+		t.Errorf("failed: mul lists")
+	}
+	if dot, err := Dot(l1, l2, ZipStrict); dot != 32 || err != nil {
+		t.Errorf("failed: dot lists, got %d, err %v", dot, err)
+	}
+	if dot, err := Dot(l1, GetLinkedList([]int{4}), ZipPad); dot != 4 || err != nil {
+		t.Errorf("failed: dot with pad, got %d, err %v", dot, err)
+	}
+	if _, err := Dot(l1, GetLinkedList([]int{4}), ZipStrict); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("failed: dot with different lengths, err %v", err)
+	}
+	max3 := func(a, b int) int { return max(a, b, 3) }
+	if result, _ := ZipWith(l1, GetLinkedList([]int{0, 9}), ZipPad, max3); !EqualLists(result, GetLinkedList([]int{3, 9, 3})) {
+		t.Errorf("failed: zip with custom function")
+	}
+}
+
+func TestZipChecked(t *testing.T) {
+	tests := []struct {
+		name    string
+		op      func(l1, l2 *LinkedList, mode ZipMode) (*LinkedList, error)
+		inputL1 []int
+		inputL2 []int
+		index   int // -1 without overflow
+	}{
+		{"Test1: ", AddChecked, []int{1, math.MaxInt}, []int{1, 1}, 1},
+		{"Test2: ", AddChecked, []int{math.MaxInt, math.MinInt}, []int{math.MinInt, math.MaxInt}, -1},
+		{"Test3: ", SubChecked, []int{0, 0, math.MinInt}, []int{1, math.MinInt}, 1},
+		{"Test4: ", SubChecked, []int{-1, math.MinInt}, []int{math.MaxInt}, -1},
+		{"Test5: ", MulChecked, []int{2, math.MaxInt/2 + 1}, []int{3, 2}, 1},
+		{"Test6: ", MulChecked, []int{3, math.MinInt}, []int{0, -1}, 1},
+		{"Test7: ", MulChecked, []int{-1, math.MinInt / 2}, []int{math.MinInt + 1, 2}, -1},
+	}
+
+	for _, test := range tests {
+		result, err := test.op(GetLinkedList(test.inputL1), GetLinkedList(test.inputL2), ZipPad)
+
+		var overflow *OverflowError
+		if test.index < 0 {
+			if err != nil || result.Count() != max(len(test.inputL1), len(test.inputL2)) {
+				t.Errorf("failed %s: checked operation without overflow, err %v", test.name, err)
+			}
+			continue
+		}
+		if !errors.As(err, &overflow) || !errors.Is(err, ErrOverflow) || overflow.Index != test.index {
+			t.Errorf("failed %s: overflow at %d, err %v", test.name, test.index, err)
+		}
+		if result.Count() != 0 {
+			t.Errorf("failed %s: overflowed result must be empty", test.name)
+		}
+	}
+
+	l1, l2 := GetLinkedList([]int{1, math.MaxInt / 2, 1}), GetLinkedList([]int{1, 2, 1})
+	var overflow *OverflowError
+	if _, err := DotChecked(l1, l2, ZipStrict); !errors.As(err, &overflow) || overflow.Index != 2 || overflow.Op != "dot" {
+		t.Errorf("failed: dot overflow of sum, err %v", err)
+	}
+	if dot, err := DotChecked(l1, GetLinkedList([]int{1, 2}), ZipTruncate); err != nil || dot != math.MaxInt {
+		t.Errorf("failed: dot without overflow, got %d, err %v", dot, err)
+	}
+}
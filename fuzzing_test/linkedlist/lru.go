@@ -0,0 +1,139 @@
+package linkedlist
+
+import (
+	"time"
+)
+
+type lruEntry[K comparable, V any] struct {
+	key     K
+	value   V
+	expires time.Time // zero for entry without TTL
+}
+
+// LRUStats counts lookups by Get and evictions by capacity and TTL
+type LRUStats struct {
+	Hits      int
+	Misses    int
+	Evictions int
+}
+
+// LRU is a bounded cache, the most recently used entry is in the head
+// of the doubly list, so eviction from the tail and moving to the head take O(1).
+// It is not safe for concurrent use
+type LRU[K comparable, V any] struct {
+	capacity int
+	items    map[K]*DoublyNode[lruEntry[K, V]]
+	order    DoublyList[lruEntry[K, V]]
+	onEvict  func(key K, value V)
+	now      func() time.Time
+	stats    LRUStats
+}
+
+// capacity less than 1 means cache of one entry,
+// onEvict may be nil, it is not called for Remove and for replaced values
+func NewLRU[K comparable, V any](capacity int, onEvict func(key K, value V)) *LRU[K, V] {
+	return &LRU[K, V]{
+		capacity: max(capacity, 1),
+		items:    make(map[K]*DoublyNode[lruEntry[K, V]]),
+		onEvict:  onEvict,
+		now:      time.Now,
+	}
+}
+
+// t = O(1)
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	node, ok := c.lookup(key)
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	c.order.MoveToFront(node)
+	return node.value.value, true
+}
+
+// Peek returns value without changing recency and statistics,
+// expired entry is a miss, but it is left for the next Get or eviction
+// t = O(1)
+func (c *LRU[K, V]) Peek(key K) (V, bool) {
+	node, ok := c.items[key]
+	if !ok || c.expired(node) {
+		var zero V
+		return zero, false
+	}
+	return node.value.value, true
+}
+
+// t = O(1)
+func (c *LRU[K, V]) Put(key K, value V) {
+	c.PutWithTTL(key, value, 0)
+}
+
+// ttl <= 0 means entry without expiration
+// t = O(1)
+func (c *LRU[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	entry := lruEntry[K, V]{key: key, value: value}
+	if ttl > 0 {
+		entry.expires = c.now().Add(ttl)
+	}
+
+	if node, ok := c.items[key]; ok {
+		node.value = entry
+		c.order.MoveToFront(node)
+		return
+	}
+	if c.order.Count() == c.capacity {
+		c.evict(c.order.tail)
+	}
+	c.items[key] = c.order.InsertFirst(entry)
+}
+
+// t = O(1)
+func (c *LRU[K, V]) Remove(key K) bool {
+	node, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	delete(c.items, key)
+	c.order.Remove(node)
+	return true
+}
+
+// Len counts expired entries which are not looked up by Get yet
+// t = O(1)
+func (c *LRU[K, V]) Len() int {
+	return c.order.Count()
+}
+
+func (c *LRU[K, V]) Stats() LRUStats {
+	return c.stats
+}
+
+// expired entry is evicted on lookup
+func (c *LRU[K, V]) lookup(key K) (*DoublyNode[lruEntry[K, V]], bool) {
+	node, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	if c.expired(node) {
+		c.evict(node)
+		return nil, false
+	}
+	return node, true
+}
+
+func (c *LRU[K, V]) expired(node *DoublyNode[lruEntry[K, V]]) bool {
+	expires := node.value.expires
+	return !expires.IsZero() && !c.now().Before(expires)
+}
+
+func (c *LRU[K, V]) evict(node *DoublyNode[lruEntry[K, V]]) {
+	entry := node.value
+	delete(c.items, entry.key)
+	c.order.Remove(node)
+	c.stats.Evictions++
+	if c.onEvict != nil {
+		c.onEvict(entry.key, entry.value)
+	}
+}
@@ -0,0 +1,148 @@
+package linkedlist
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+// naiveLRU keeps keys in slice from the most recently used,
+// every operation is O(n)
+type naiveLRU struct {
+	capacity int
+	keys     []int
+	values   map[int]int
+	expires  map[int]time.Time
+	evicted  []int
+	stats    LRUStats // hits and misses of get, evictions of drop
+	now      func() time.Time
+}
+
+func (c *naiveLRU) expired(key int) bool {
+	expires, ok := c.expires[key]
+	return ok && !c.now().Before(expires)
+}
+
+func (c *naiveLRU) drop(key int, evicted bool) {
+	c.keys = slices.DeleteFunc(c.keys, func(k int) bool { return k == key })
+	delete(c.values, key)
+	delete(c.expires, key)
+	if evicted {
+		c.evicted = append(c.evicted, key)
+		c.stats.Evictions++
+	}
+}
+
+func (c *naiveLRU) peek(key int) (int, bool) {
+	if _, ok := c.values[key]; !ok || c.expired(key) {
+		return 0, false
+	}
+	return c.values[key], true
+}
+
+func (c *naiveLRU) get(key int) (int, bool) {
+	if _, ok := c.values[key]; ok && c.expired(key) {
+		c.drop(key, true)
+	}
+	value, ok := c.peek(key)
+	if !ok {
+		c.stats.Misses++
+	} else {
+		c.stats.Hits++
+		c.keys = slices.DeleteFunc(c.keys, func(k int) bool { return k == key })
+		c.keys = slices.Insert(c.keys, 0, key)
+	}
+	return value, ok
+}
+
+func (c *naiveLRU) put(key, value int, ttl time.Duration) {
+	if _, ok := c.values[key]; ok {
+		c.keys = slices.DeleteFunc(c.keys, func(k int) bool { return k == key })
+	} else if len(c.keys) == c.capacity {
+		c.drop(c.keys[len(c.keys)-1], true)
+	}
+	c.keys = slices.Insert(c.keys, 0, key)
+	c.values[key] = value
+	delete(c.expires, key)
+	if ttl > 0 {
+		c.expires[key] = c.now().Add(ttl)
+	}
+}
+
+func FuzzLRU(f *testing.F) {
+	f.Add(uint8(2), []byte{0, 1, 1, 0, 2, 2, 1, 1, 0, 0, 3, 3, 1, 2, 0})
+	f.Add(uint8(1), []byte{0, 1, 9, 4, 0, 0, 1, 1, 0})
+	f.Add(uint8(3), []byte{0, 1, 1, 3, 2, 0, 2, 1, 0, 1, 1, 0})
+
+	f.Fuzz(func(t *testing.T, capacity uint8, data []byte) {
+		if len(data) > 3000 {
+			t.Skip()
+		}
+		clock := &fakeClock{now: time.Unix(0, 0)}
+		var evicted []int
+		cache := NewLRU(int(capacity%8), func(key, value int) {
+			evicted = append(evicted, key)
+		})
+		cache.now = clock.Now
+		model := &naiveLRU{
+			capacity: max(int(capacity%8), 1),
+			values:   map[int]int{},
+			expires:  map[int]time.Time{},
+			now:      clock.Now,
+		}
+
+		// every op is 3 bytes: kind, key and value or ttl in seconds
+		for i := 0; i+2 < len(data); i += 3 {
+			kind, key, arg := data[i]%6, int(data[i+1]%8), int(data[i+2])
+			switch kind {
+			case 0:
+				cache.Put(key, arg)
+				model.put(key, arg, 0)
+			case 1:
+				ttl := time.Duration(arg%4) * time.Second
+				cache.PutWithTTL(key, arg, ttl)
+				model.put(key, arg, ttl)
+			case 2:
+				got, gotOk := cache.Get(key)
+				want, wantOk := model.get(key)
+				if got != want || gotOk != wantOk {
+					t.Fatalf("Get(%d) mismatch: got=%d %v want=%d %v", key, got, gotOk, want, wantOk)
+				}
+			case 3:
+				stats := cache.Stats()
+				got, gotOk := cache.Peek(key)
+				want, wantOk := model.peek(key)
+				if got != want || gotOk != wantOk {
+					t.Fatalf("Peek(%d) mismatch: got=%d %v want=%d %v", key, got, gotOk, want, wantOk)
+				}
+				if cache.Stats() != stats {
+					t.Fatalf("Peek(%d) changed stats: got=%+v want=%+v", key, cache.Stats(), stats)
+				}
+			case 4:
+				_, wantOk := model.values[key]
+				if got := cache.Remove(key); got != wantOk {
+					t.Fatalf("Remove(%d) mismatch: got=%v want=%v", key, got, wantOk)
+				}
+				model.drop(key, false)
+			case 5:
+				clock.now = clock.now.Add(time.Duration(arg%3) * time.Second)
+			}
+
+			if err := cache.order.Validate(); err != nil {
+				t.Fatalf("op %d: %v", i/3, err)
+			}
+			if got := lruKeys(cache); !slices.Equal(got, model.keys) {
+				t.Fatalf("op %d: order mismatch: got=%v want=%v", i/3, got, model.keys)
+			}
+			if len(cache.items) != cache.Len() || cache.Len() > cache.capacity {
+				t.Fatalf("op %d: map has %d items, list has %d", i/3, len(cache.items), cache.Len())
+			}
+			if !slices.Equal(evicted, model.evicted) {
+				t.Fatalf("op %d: evictions mismatch: got=%v want=%v", i/3, evicted, model.evicted)
+			}
+			if stats := cache.Stats(); stats != model.stats {
+				t.Fatalf("op %d: stats mismatch: got=%+v want=%+v", i/3, stats, model.stats)
+			}
+		}
+	})
+}
@@ -0,0 +1,140 @@
+package linkedlist
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// fakeClock moves only by hand, so TTL tests do not sleep
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func lruKeys[K comparable, V any](c *LRU[K, V]) []K {
+	var keys []K
+	for entry := range c.order.All() {
+		keys = append(keys, entry.key)
+	}
+	return keys
+}
+
+func TestLRUEviction(t *testing.T) {
+	var evicted []string
+	cache := NewLRU(2, func(key string, value int) {
+		evicted = append(evicted, key+"="+strconv.Itoa(value))
+	})
+
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	if value, ok := cache.Get("a"); !ok || value != 1 {
+		t.Errorf("failed: get a, got %d %v", value, ok)
+	}
+	cache.Put("c", 3) // b is the least recently used
+	if _, ok := cache.Peek("b"); ok {
+		t.Errorf("failed: b must be evicted")
+	}
+	if !slices.Equal(evicted, []string{"b=2"}) {
+		t.Errorf("failed: eviction callback, got %v", evicted)
+	}
+
+	// peek does not change order, so a is evicted
+	cache.Peek("a")
+	cache.Put("c", 30)
+	cache.Put("d", 4)
+	if got := lruKeys(cache); !slices.Equal(got, []string{"d", "c"}) {
+		t.Errorf("failed: order after peek, got %v", got)
+	}
+
+	if !cache.Remove("c") || cache.Remove("c") || cache.Len() != 1 {
+		t.Errorf("failed: remove")
+	}
+	if !slices.Equal(evicted, []string{"b=2", "a=1"}) {
+		t.Errorf("failed: remove and replace must not call callback, got %v", evicted)
+	}
+
+	cache.Get("x")
+	if stats := cache.Stats(); stats != (LRUStats{Hits: 1, Misses: 1, Evictions: 2}) {
+		t.Errorf("failed: stats, got %+v", stats)
+	}
+}
+
+func TestLRUTTL(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	var evicted []int
+	cache := NewLRU(0, func(key int, value string) {
+		evicted = append(evicted, key)
+	})
+	cache.now = clock.Now
+
+	cache.PutWithTTL(1, "a", time.Second)
+	if cache.capacity != 1 {
+		t.Errorf("failed: capacity less than 1, got %d", cache.capacity)
+	}
+	clock.now = clock.now.Add(999 * time.Millisecond)
+	if _, ok := cache.Get(1); !ok {
+		t.Errorf("failed: entry expired too early")
+	}
+	clock.now = clock.now.Add(time.Millisecond)
+	stats := cache.Stats()
+	if _, ok := cache.Peek(1); ok || cache.Len() != 1 {
+		t.Errorf("failed: expired entry by Peek, got ok=%v len=%d", ok, cache.Len())
+	}
+	if len(evicted) != 0 || cache.Stats() != stats {
+		t.Errorf("failed: Peek must not evict, got %v %+v", evicted, cache.Stats())
+	}
+	if _, ok := cache.Get(1); ok || cache.Len() != 0 {
+		t.Errorf("failed: entry must expire")
+	}
+	if !slices.Equal(evicted, []int{1}) {
+		t.Errorf("failed: expired entry callback, got %v", evicted)
+	}
+
+	// Put without ttl clears old expiration
+	cache.PutWithTTL(2, "b", time.Second)
+	cache.Put(2, "c")
+	clock.now = clock.now.Add(time.Hour)
+	if value, ok := cache.Get(2); !ok || value != "c" {
+		t.Errorf("failed: entry without ttl, got %q %v", value, ok)
+	}
+}
+
+func BenchmarkLRUPut(b *testing.B) {
+	cache := NewLRU[int, int](1024, nil)
+	b.ReportAllocs()
+	i := 0
+	for b.Loop() {
+		cache.Put(i%4096, i)
+		i++
+	}
+}
+
+func BenchmarkLRUGetHit(b *testing.B) {
+	cache := NewLRU[int, int](1024, nil)
+	for i := range 1024 {
+		cache.Put(i, i)
+	}
+	b.ReportAllocs()
+	i := 0
+	for b.Loop() {
+		cache.Get(i % 1024)
+		i++
+	}
+}
+
+func BenchmarkLRUMixed(b *testing.B) {
+	cache := NewLRU[int, int](1024, nil)
+	b.ReportAllocs()
+	i := 0
+	for b.Loop() {
+		if _, ok := cache.Get(i % 2048); !ok {
+			cache.Put(i%2048, i)
+		}
+		i++
+	}
+}
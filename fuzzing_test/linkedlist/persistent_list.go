@@ -0,0 +1,159 @@
+package linkedlist
+
+import (
+	"iter"
+)
+
+type persistentNode[T any] struct {
+	next  *persistentNode[T]
+	value T
+}
+
+// PersistentList is an immutable singly linked list, every change returns
+// a new version which shares unchanged suffix with the old one,
+// so old versions stay valid for undo and audit.
+// Zero value is an empty list
+type PersistentList[T any] struct {
+	head   *persistentNode[T]
+	length int
+}
+
+func GetPersistentList[T any](values []T) PersistentList[T] {
+	var resultL PersistentList[T]
+	for i := len(values) - 1; i >= 0; i-- {
+		resultL = resultL.Cons(values[i])
+	}
+	return resultL
+}
+
+// Cons returns version with value in the head, all nodes are shared
+// t = O(1)
+func (l PersistentList[T]) Cons(value T) PersistentList[T] {
+	return PersistentList[T]{
+		head:   &persistentNode[T]{next: l.head, value: value},
+		length: l.length + 1,
+	}
+}
+
+// Head returns the first value, false for empty list
+// t = O(1)
+func (l PersistentList[T]) Head() (T, bool) {
+	if l.head == nil {
+		var zero T
+		return zero, false
+	}
+	return l.head.value, true
+}
+
+// Tail returns version without the head, all nodes are shared,
+// tail of empty list is empty list
+// t = O(1)
+func (l PersistentList[T]) Tail() PersistentList[T] {
+	if l.head == nil {
+		return l
+	}
+	return PersistentList[T]{head: l.head.next, length: l.length - 1}
+}
+
+// Append copies all nodes, because the last node of the old version
+// can not point to the new one
+// t = O(n), where n = len(list)
+func (l PersistentList[T]) Append(value T) PersistentList[T] {
+	return l.Insert(l.length, value)
+}
+
+// Insert returns version with value at index, nodes after index are shared,
+// index is clamped to [0, len]
+// t = O(k), where k = index
+func (l PersistentList[T]) Insert(index int, value T) PersistentList[T] {
+	index = min(max(index, 0), l.length)
+	prefix, rest := l.copyPrefix(index)
+	return prefix.join(&persistentNode[T]{next: rest, value: value}, l.length+1)
+}
+
+// Delete returns version without the first value matching by equal,
+// nodes after it are shared, list itself is returned if nothing is deleted
+// t = O(n), where n = len(list)
+func (l PersistentList[T]) Delete(value T, equal func(a, b T) bool) PersistentList[T] {
+	index := 0
+	for tempNode := l.head; tempNode != nil; tempNode = tempNode.next {
+		if equal(tempNode.value, value) {
+			prefix, rest := l.copyPrefix(index)
+			return prefix.join(rest.next, l.length-1)
+		}
+		index++
+	}
+	return l
+}
+
+// copied prefix is kept as its first and last nodes
+type persistentPrefix[T any] struct {
+	first *persistentNode[T]
+	last  *persistentNode[T]
+}
+
+// copies count first nodes and returns the rest which can be shared
+func (l PersistentList[T]) copyPrefix(count int) (persistentPrefix[T], *persistentNode[T]) {
+	var prefix persistentPrefix[T]
+	tempNode := l.head
+	for range count {
+		copied := &persistentNode[T]{value: tempNode.value}
+		if prefix.last == nil {
+			prefix.first = copied
+		} else {
+			prefix.last.next = copied
+		}
+		prefix.last = copied
+		tempNode = tempNode.next
+	}
+	return prefix, tempNode
+}
+
+func (p persistentPrefix[T]) join(rest *persistentNode[T], length int) PersistentList[T] {
+	if p.last == nil {
+		return PersistentList[T]{head: rest, length: length}
+	}
+	p.last.next = rest
+	return PersistentList[T]{head: p.first, length: length}
+}
+
+// t = O(1)
+func (l PersistentList[T]) Count() int {
+	return l.length
+}
+
+func (l PersistentList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for tempNode := l.head; tempNode != nil; tempNode = tempNode.next {
+			if !yield(tempNode.value) {
+				return
+			}
+		}
+	}
+}
+
+// Equal compares lengths and values position by position like EqualLists,
+// shared suffix is equal without comparing its values
+// t = O(n), where n = len(list)
+func (l PersistentList[T]) Equal(other PersistentList[T], equal func(a, b T) bool) bool {
+	if l.length != other.length {
+		return false
+	}
+	tempL1, tempL2 := l.head, other.head
+	for tempL1 != tempL2 {
+		if !equal(tempL1.value, tempL2.value) {
+			return false
+		}
+		tempL1, tempL2 = tempL1.next, tempL2.next
+	}
+	return true
+}
+
+// PersistentFromLinkedList copies values of LinkedList into persistent version
+func PersistentFromLinkedList(l *LinkedList) PersistentList[int] {
+	var values []int
+	for value := range l.All() {
+		values = append(values, value)
+	}
+	return GetPersistentList(values)
+}
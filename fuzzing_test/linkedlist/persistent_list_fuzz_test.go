@@ -0,0 +1,74 @@
+package linkedlist
+
+import (
+	"slices"
+	"testing"
+)
+
+// every op makes a new version from one of the old ones,
+// after every op all versions must be equal to their snapshots
+func FuzzPersistentList(f *testing.F) {
+	f.Add(intsToBytes([]int{}))
+	f.Add(intsToBytes([]int{0, 1, 4, 2, 2, 3, 3, 0}))
+	f.Add(intsToBytes([]int{1, 5, 1, 6, 2, 5, 4, 1, 0, 2}))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if len(data) > 3000 {
+			t.Skip()
+		}
+		ints := bytesToInts(data, 300)
+
+		versions := []PersistentList[int]{{}}
+		snapshots := [][]int{{}}
+		for i := 0; i+1 < len(ints); i += 2 {
+			kind, arg := uint(ints[i])%5, ints[i+1]%8
+			from := uint(ints[i]) / 5 % uint(len(versions))
+			base, model := versions[from], slices.Clone(snapshots[from])
+
+			var next PersistentList[int]
+			switch kind {
+			case 0:
+				next = base.Cons(arg)
+				model = slices.Insert(model, 0, arg)
+			case 1:
+				next = base.Tail()
+				if len(model) > 0 {
+					model = model[1:]
+				}
+			case 2:
+				next = base.Append(arg)
+				model = append(model, arg)
+			case 3:
+				index := min(max(arg, 0), len(model))
+				next = base.Insert(arg, -arg)
+				model = slices.Insert(model, index, -arg)
+			case 4:
+				next = base.Delete(arg, equalInts)
+				if pos := slices.Index(model, arg); pos >= 0 {
+					model = slices.Delete(model, pos, pos+1)
+				}
+			}
+			versions = append(versions, next)
+			snapshots = append(snapshots, model)
+
+			for v := range versions {
+				got := slices.Collect(versions[v].All())
+				if !slices.Equal(got, snapshots[v]) || versions[v].Count() != len(snapshots[v]) {
+					t.Fatalf("version %d changed after op %d: got=%v want=%v", v, i/2, got, snapshots[v])
+				}
+			}
+		}
+
+		// Equal gives the same answer as EqualLists on the same values
+		for v := range versions {
+			other := versions[len(versions)-1-v]
+			want := slices.Equal(snapshots[v], snapshots[len(versions)-1-v])
+			if got := versions[v].Equal(other, equalInts); got != want {
+				t.Fatalf("Equal mismatch for %v and %v: got=%v", snapshots[v], snapshots[len(versions)-1-v], got)
+			}
+			if EqualLists(GetLinkedList(snapshots[v]), GetLinkedList(snapshots[len(versions)-1-v])) != want {
+				t.Fatalf("EqualLists disagrees with Equal for %v and %v", snapshots[v], snapshots[len(versions)-1-v])
+			}
+		}
+	})
+}
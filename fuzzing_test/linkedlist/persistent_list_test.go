@@ -0,0 +1,59 @@
+package linkedlist
+
+import (
+	"slices"
+	"testing"
+)
+
+func equalInts(a, b int) bool {
+	return a == b
+}
+
+func TestPersistentList(t *testing.T) {
+	v1 := GetPersistentList([]int{1, 2, 3})
+	v2 := v1.Cons(0)
+	v3 := v2.Insert(2, 9)
+	v4 := v3.Delete(9, equalInts)
+	v5 := v4.Append(4)
+	v6 := v5.Tail()
+
+	versions := []struct {
+		name string
+		list PersistentList[int]
+		want []int
+	}{
+		{"v1: ", v1, []int{1, 2, 3}},
+		{"v2: ", v2, []int{0, 1, 2, 3}},
+		{"v3: ", v3, []int{0, 1, 9, 2, 3}},
+		{"v4: ", v4, []int{0, 1, 2, 3}},
+		{"v5: ", v5, []int{0, 1, 2, 3, 4}},
+		{"v6: ", v6, []int{1, 2, 3, 4}},
+	}
+	for _, version := range versions {
+		if got := slices.Collect(version.list.All()); !slices.Equal(got, version.want) || version.list.Count() != len(version.want) {
+			t.Errorf("failed %s: got %v, want %v", version.name, got, version.want)
+		}
+	}
+
+	// unchanged suffixes are shared, not copied
+	if v2.head.next != v1.head {
+		t.Errorf("failed: cons must share the old list")
+	}
+	if v3.head.next.next.next != v1.head.next {
+		t.Errorf("failed: insert must share nodes after index")
+	}
+	if !v4.Equal(v2, equalInts) || v4.Equal(v3, equalInts) || v1.Equal(v6, equalInts) {
+		t.Errorf("failed: equal versions")
+	}
+	if v7 := v1.Delete(10, equalInts); v7.head != v1.head {
+		t.Errorf("failed: delete of missing value must return the same list")
+	}
+
+	var empty PersistentList[int]
+	if _, ok := empty.Head(); ok || empty.Tail().Count() != 0 || !empty.Equal(GetPersistentList([]int{}), equalInts) {
+		t.Errorf("failed: empty list")
+	}
+	if !PersistentFromLinkedList(GetLinkedList([]int{1, 2, 3})).Equal(v1, equalInts) {
+		t.Errorf("failed: persistent from linked list")
+	}
+}
@@ -0,0 +1,174 @@
+package linkedlist
+
+import (
+	"iter"
+	"math/rand/v2"
+)
+
+const (
+	skipListMaxLevel = 24
+	// every next level keeps about 1/4 nodes of the lower one
+	skipListP = 4
+)
+
+// skipNode is ListNode with next link per level,
+// span[i] is count of level 0 steps up to next[i], it is used by Rank
+type skipNode[T any] struct {
+	next  []*skipNode[T]
+	span  []int
+	value T
+}
+
+// SkipList is an ordered set, Insert, Delete, Contains and Rank take O(log(n)) on average.
+// Levels come from RNG with the seed passed to the constructor,
+// so the same seed and operations give the same structure
+type SkipList[T any] struct {
+	head   *skipNode[T] // head does not keep a value
+	level  int
+	length int
+	cmp    func(a, b T) int
+	rng    *rand.Rand
+}
+
+// cmp is in style of cmp.Compare
+func NewSkipList[T any](cmp func(a, b T) int, seed uint64) *SkipList[T] {
+	return &SkipList[T]{
+		head: &skipNode[T]{
+			next: make([]*skipNode[T], skipListMaxLevel),
+			span: make([]int, skipListMaxLevel),
+		},
+		level: 1,
+		cmp:   cmp,
+		rng:   rand.New(rand.NewPCG(seed, seed)),
+	}
+}
+
+func (s *SkipList[T]) randomLevel() int {
+	level := 1
+	for level < skipListMaxLevel && s.rng.IntN(skipListP) == 0 {
+		level++
+	}
+	return level
+}
+
+// finds last node less than value on every level and its rank
+func (s *SkipList[T]) search(value T) (update [skipListMaxLevel]*skipNode[T], rank [skipListMaxLevel]int) {
+	tempNode := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		if i < s.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for tempNode.next[i] != nil && s.cmp(tempNode.next[i].value, value) < 0 {
+			rank[i] += tempNode.span[i]
+			tempNode = tempNode.next[i]
+		}
+		update[i] = tempNode
+	}
+	return update, rank
+}
+
+// returns false if value is already in the set
+// t = O(log(n)) on average
+func (s *SkipList[T]) Insert(value T) bool {
+	update, rank := s.search(value)
+	if next := update[0].next[0]; next != nil && s.cmp(next.value, value) == 0 {
+		return false
+	}
+
+	level := s.randomLevel()
+	for i := s.level; i < level; i++ {
+		update[i] = s.head
+		rank[i] = 0
+		s.head.span[i] = s.length
+	}
+	s.level = max(s.level, level)
+
+	add := &skipNode[T]{
+		next:  make([]*skipNode[T], level),
+		span:  make([]int, level),
+		value: value,
+	}
+	for i := range level {
+		add.next[i] = update[i].next[i]
+		update[i].next[i] = add
+		add.span[i] = update[i].span[i] - (rank[0] - rank[i])
+		update[i].span[i] = rank[0] - rank[i] + 1
+	}
+	for i := level; i < s.level; i++ {
+		update[i].span[i]++
+	}
+	s.length++
+	return true
+}
+
+// returns false if value is not in the set
+// t = O(log(n)) on average
+func (s *SkipList[T]) Delete(value T) bool {
+	update, _ := s.search(value)
+	deleted := update[0].next[0]
+	if deleted == nil || s.cmp(deleted.value, value) != 0 {
+		return false
+	}
+
+	for i := range s.level {
+		if update[i].next[i] == deleted {
+			update[i].span[i] += deleted.span[i] - 1
+			update[i].next[i] = deleted.next[i]
+		} else {
+			update[i].span[i]--
+		}
+	}
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+	s.length--
+	return true
+}
+
+// t = O(log(n)) on average
+func (s *SkipList[T]) Contains(value T) bool {
+	update, _ := s.search(value)
+	next := update[0].next[0]
+	return next != nil && s.cmp(next.value, value) == 0
+}
+
+// Rank is count of values less than value,
+// it is position of value in the set or position to insert it
+// t = O(log(n)) on average
+func (s *SkipList[T]) Rank(value T) int {
+	_, rank := s.search(value)
+	return rank[0]
+}
+
+// t = O(1)
+func (s *SkipList[T]) Len() int {
+	return s.length
+}
+
+// All iterates values in ascending order
+func (s *SkipList[T]) All() iter.Seq[T] {
+	return s.from(s.head.next[0], nil)
+}
+
+// Range iterates values in [lo, hi) in ascending order
+// t = O(log(n)+k), where k - count of values in range
+func (s *SkipList[T]) Range(lo, hi T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		update, _ := s.search(lo)
+		s.from(update[0].next[0], &hi)(yield)
+	}
+}
+
+// iterates from node up to hi, nil hi means up to the end
+func (s *SkipList[T]) from(node *skipNode[T], hi *T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for tempNode := node; tempNode != nil; tempNode = tempNode.next[0] {
+			if hi != nil && s.cmp(tempNode.value, *hi) >= 0 {
+				return
+			}
+			if !yield(tempNode.value) {
+				return
+			}
+		}
+	}
+}
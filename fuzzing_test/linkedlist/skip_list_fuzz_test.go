@@ -0,0 +1,61 @@
+package linkedlist
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+
+	"minimize_steps/example2"
+)
+
+func FuzzSkipList(f *testing.F) {
+	f.Add(uint64(1), intsToBytes([]int{}))
+	f.Add(uint64(2), intsToBytes([]int{1, 2, 3, -1, -2, 2}))
+	f.Add(uint64(3), intsToBytes([]int{5, 5, 5, -5, 0, 7, 1}))
+
+	f.Fuzz(func(t *testing.T, seed uint64, data []byte) {
+		if len(data) > 3000 {
+			t.Skip()
+		}
+		ints := bytesToInts(data, 300)
+		set := NewSkipList(cmp.Compare[int], seed)
+		model := []int{} // sorted slice without duplicates
+
+		for _, v := range ints {
+			// small values repeat, so deletes hit existing values
+			value := v % 32
+			pos := example2.BinarySearchLeft(model, value)
+			exists := pos < len(model) && model[pos] == value
+
+			if got := set.Rank(value); got != pos {
+				t.Fatalf("Rank(%d) mismatch: got=%d want=%d model=%v", value, got, pos, model)
+			}
+			if got := set.Contains(value); got != exists {
+				t.Fatalf("Contains(%d) mismatch: got=%v want=%v", value, got, exists)
+			}
+
+			if v < 0 {
+				if got := set.Delete(value); got != exists {
+					t.Fatalf("Delete(%d) mismatch: got=%v want=%v", value, got, exists)
+				}
+				if exists {
+					model = slices.Delete(model, pos, pos+1)
+				}
+			} else {
+				if got := set.Insert(value); got == exists {
+					t.Fatalf("Insert(%d) mismatch: got=%v want=%v", value, got, !exists)
+				}
+				if !exists {
+					model = slices.Insert(model, pos, value)
+				}
+			}
+			checkSkipList(t, set, model)
+
+			lo, hi := value-4, value+4
+			from, to := example2.BinarySearchLeft(model, lo), example2.BinarySearchLeft(model, hi)
+			if got := slices.Collect(set.Range(lo, hi)); !slices.Equal(got, model[from:to]) {
+				t.Fatalf("Range(%d, %d) mismatch: got=%v want=%v", lo, hi, got, model[from:to])
+			}
+		}
+	})
+}
@@ -0,0 +1,85 @@
+package linkedlist
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+// checks order, length and spans on every level
+func checkSkipList(t *testing.T, s *SkipList[int], want []int) {
+	t.Helper()
+	if got := slices.Collect(s.All()); !slices.Equal(got, want) || s.Len() != len(want) {
+		t.Fatalf("skip list mismatch: got=%v len=%d want=%v", got, s.Len(), want)
+	}
+	position := map[*skipNode[int]]int{s.head: 0}
+	indx := 1
+	for node := s.head.next[0]; node != nil; node = node.next[0] {
+		position[node] = indx
+		indx++
+	}
+	for level := range s.level {
+		for node := s.head; node != nil; node = node.next[level] {
+			// span of the last node of the level counts steps up to the end
+			wantSpan := len(want) - position[node]
+			if next := node.next[level]; next != nil {
+				wantSpan = position[next] - position[node]
+			}
+			if node.span[level] != wantSpan {
+				t.Fatalf("span mismatch on level %d at %d: got=%d want=%d", level, position[node], node.span[level], wantSpan)
+			}
+		}
+	}
+	if s.level > 1 && s.head.next[s.level-1] == nil {
+		t.Fatalf("empty top level %d", s.level)
+	}
+}
+
+func TestSkipList(t *testing.T) {
+	set := NewSkipList(cmp.Compare[int], 1)
+	for _, value := range []int{22, 3, 2, 45, 6, 3} {
+		set.Insert(value)
+	}
+	checkSkipList(t, set, []int{2, 3, 6, 22, 45})
+
+	if set.Insert(6) || !set.Contains(6) || set.Contains(7) {
+		t.Errorf("failed: contains")
+	}
+	if rank := set.Rank(6); rank != 2 {
+		t.Errorf("failed: rank of 6, got %d", rank)
+	}
+	if rank := set.Rank(100); rank != 5 {
+		t.Errorf("failed: rank of missing value, got %d", rank)
+	}
+	if got := slices.Collect(set.Range(3, 45)); !slices.Equal(got, []int{3, 6, 22}) {
+		t.Errorf("failed: range, got %v", got)
+	}
+	if got := slices.Collect(set.Range(4, 5)); len(got) != 0 {
+		t.Errorf("failed: empty range, got %v", got)
+	}
+
+	if !set.Delete(3) || set.Delete(3) {
+		t.Errorf("failed: delete")
+	}
+	checkSkipList(t, set, []int{2, 6, 22, 45})
+}
+
+func TestSkipListSeed(t *testing.T) {
+	levels := func(seed uint64) []int {
+		set := NewSkipList(cmp.Compare[int], seed)
+		var out []int
+		for value := range 100 {
+			set.Insert(value)
+		}
+		for node := set.head.next[0]; node != nil; node = node.next[0] {
+			out = append(out, len(node.next))
+		}
+		return out
+	}
+	if !slices.Equal(levels(7), levels(7)) {
+		t.Errorf("failed: the same seed must give the same levels")
+	}
+	if slices.Equal(levels(7), levels(8)) {
+		t.Errorf("failed: different seeds give the same levels")
+	}
+}
@@ -0,0 +1,237 @@
+package linkedlist
+
+import (
+	"fmt"
+	"iter"
+)
+
+// values per node, node of 16 ints with next and count takes 3 cache lines
+const unrolledCap = 16
+
+type unrolledNode struct {
+	next   *unrolledNode
+	count  int
+	values [unrolledCap]int
+}
+
+// UnrolledList keeps up to unrolledCap values per node, so traversal reads
+// memory one by one like slice. Values move between nodes on insert and delete,
+// so positions are used instead of node handles.
+// Every node except the last is at least half full
+type UnrolledList struct {
+	head   *unrolledNode
+	tail   *unrolledNode
+	length int
+}
+
+func GetUnrolledList(values []int) *UnrolledList {
+	var resultL UnrolledList
+	for _, value := range values {
+		resultL.AddInTail(value)
+	}
+	return &resultL
+}
+
+// t = O(1)
+func (l *UnrolledList) AddInTail(value int) {
+	if l.tail == nil || l.tail.count == unrolledCap {
+		node := &unrolledNode{}
+		if l.tail == nil {
+			l.head = node
+		} else {
+			l.tail.next = node
+		}
+		l.tail = node
+	}
+	l.tail.values[l.tail.count] = value
+	l.tail.count++
+	l.length++
+}
+
+// t = O(1)
+func (l *UnrolledList) InsertFirst(value int) {
+	if l.head == nil {
+		l.AddInTail(value)
+		return
+	}
+	l.insertInto(l.head, 0, value)
+}
+
+// Insert puts value at index, so it becomes the value at index,
+// index == Count() adds value in tail
+// t = O(n/unrolledCap), where n = len(list)
+func (l *UnrolledList) Insert(index int, value int) error {
+	if index < 0 || index > l.length {
+		return fmt.Errorf("%w: %d not in [0, %d]", ErrOutOfRange, index, l.length)
+	}
+	if index == l.length {
+		l.AddInTail(value)
+		return nil
+	}
+	node := l.head
+	for index >= node.count {
+		index -= node.count
+		node = node.next
+	}
+	l.insertInto(node, index, value)
+	return nil
+}
+
+// full node is split in halves before inserting
+func (l *UnrolledList) insertInto(node *unrolledNode, index int, value int) {
+	if node.count == unrolledCap {
+		half := unrolledCap / 2
+		second := &unrolledNode{next: node.next, count: unrolledCap - half}
+		copy(second.values[:], node.values[half:])
+		node.next = second
+		node.count = half
+		if l.tail == node {
+			l.tail = second
+		}
+		if index > half {
+			node, index = second, index-half
+		}
+	}
+	copy(node.values[index+1:node.count+1], node.values[index:node.count])
+	node.values[index] = value
+	node.count++
+	l.length++
+}
+
+// t = O(1)
+func (l *UnrolledList) Count() int {
+	return l.length
+}
+
+// Find returns index of the first value equal to n
+// t = O(n), where n = len(list)
+func (l *UnrolledList) Find(n int) (int, error) {
+	for indx, value := range l.Enumerate() {
+		if value == n {
+			return indx, nil
+		}
+	}
+	return -1, ErrNotFound
+}
+
+// FindAll returns indexes of all values equal to n
+// t = O(n), where n = len(list)
+func (l *UnrolledList) FindAll(n int) []int {
+	var indexes []int
+	for indx, value := range l.Enumerate() {
+		if value == n {
+			indexes = append(indexes, indx)
+		}
+	}
+	return indexes
+}
+
+// t = O(n), where n = len(list)
+func (l *UnrolledList) Delete(n int, all bool) {
+	if !all {
+		l.deleteFirst(n)
+		return
+	}
+	for node := l.head; node != nil; node = node.next {
+		kept := 0
+		for _, value := range node.values[:node.count] {
+			if value != n {
+				node.values[kept] = value
+				kept++
+			}
+		}
+		l.length -= node.count - kept
+		node.count = kept
+	}
+
+	var prev *unrolledNode
+	for node := l.head; node != nil; {
+		next := node.next
+		if l.rebalance(prev, node) {
+			prev, next = node, node.next
+		}
+		node = next
+	}
+}
+
+func (l *UnrolledList) deleteFirst(n int) {
+	var prev *unrolledNode
+	for node := l.head; node != nil; node = node.next {
+		for i, value := range node.values[:node.count] {
+			if value == n {
+				copy(node.values[i:node.count-1], node.values[i+1:node.count])
+				node.count--
+				l.length--
+				l.rebalance(prev, node)
+				return
+			}
+		}
+		prev = node
+	}
+}
+
+// rebalance keeps node at least half full by merging with next nodes
+// or borrowing values from next one, empty node is unlinked,
+// returns false if node was unlinked
+func (l *UnrolledList) rebalance(prev *unrolledNode, node *unrolledNode) bool {
+	if node.count == 0 {
+		if prev == nil {
+			l.head = node.next
+		} else {
+			prev.next = node.next
+		}
+		if l.tail == node {
+			l.tail = prev
+		}
+		return false
+	}
+	for node.count < unrolledCap/2 && node.next != nil {
+		next := node.next
+		if node.count+next.count <= unrolledCap {
+			copy(node.values[node.count:], next.values[:next.count])
+			node.count += next.count
+			node.next = next.next
+			if l.tail == next {
+				l.tail = node
+			}
+			continue
+		}
+		moved := unrolledCap/2 - node.count
+		copy(node.values[node.count:], next.values[:moved])
+		copy(next.values[:], next.values[moved:next.count])
+		node.count += moved
+		next.count -= moved
+	}
+	return true
+}
+
+// t = O(1)
+func (l *UnrolledList) Clean() {
+	l.head = nil
+	l.tail = nil
+	l.length = 0
+}
+
+func (l *UnrolledList) All() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for node := l.head; node != nil; node = node.next {
+			for _, value := range node.values[:node.count] {
+				if !yield(value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (l *UnrolledList) Enumerate() iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		indx := 0
+		for value := range l.All() {
+			if !yield(indx, value) {
+				return
+			}
+			indx++
+		}
+	}
+}
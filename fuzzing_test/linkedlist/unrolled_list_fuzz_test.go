@@ -0,0 +1,56 @@
+package linkedlist
+
+import (
+	"slices"
+	"testing"
+)
+
+func FuzzUnrolledList(f *testing.F) {
+	f.Add(intsToBytes([]int{}))
+	f.Add(intsToBytes([]int{0, 1, 0, 2, 1, 1, 2, 3, 3, 1}))
+	f.Add(intsToBytes([]int{0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 3, 1, 4, 1}))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if len(data) > 3000 {
+			t.Skip()
+		}
+		ints := bytesToInts(data, 600)
+		var list UnrolledList
+		model := []int{}
+
+		for i := 0; i+1 < len(ints); i += 2 {
+			kind, value := uint(ints[i])%6, ints[i+1]%4
+			switch kind {
+			case 0:
+				list.AddInTail(value)
+				model = append(model, value)
+			case 1:
+				list.InsertFirst(value)
+				model = slices.Insert(model, 0, value)
+			case 2:
+				index := int(uint(ints[i]) / 6 % uint(len(model)+1))
+				if err := list.Insert(index, value); err != nil {
+					t.Fatalf("Insert(%d) failed: %v", index, err)
+				}
+				model = slices.Insert(model, index, value)
+			case 3:
+				list.Delete(value, false)
+				if pos := slices.Index(model, value); pos >= 0 {
+					model = slices.Delete(model, pos, pos+1)
+				}
+			case 4:
+				list.Delete(value, true)
+				model = slices.DeleteFunc(model, func(v int) bool { return v == value })
+			case 5:
+				indx, err := list.Find(value)
+				if want := slices.Index(model, value); indx != want || (want < 0) != (err != nil) {
+					t.Fatalf("Find(%d) mismatch: got=%d err=%v want=%d", value, indx, err, want)
+				}
+				if got := list.FindAll(value); len(got) != len(slices.DeleteFunc(slices.Clone(model), func(v int) bool { return v != value })) {
+					t.Fatalf("FindAll(%d) mismatch: got=%v model=%v", value, got, model)
+				}
+			}
+			checkUnrolledList(t, &list, model)
+		}
+	})
+}
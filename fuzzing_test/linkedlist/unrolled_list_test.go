@@ -0,0 +1,131 @@
+package linkedlist
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// checks values, length, tail and that nodes except the last are half full
+func checkUnrolledList(t *testing.T, l *UnrolledList, want []int) {
+	t.Helper()
+	if got := slices.Collect(l.All()); !slices.Equal(got, want) || l.Count() != len(want) {
+		t.Fatalf("unrolled list mismatch: got=%v count=%d want=%v", got, l.Count(), want)
+	}
+	if len(want) == 0 {
+		if l.head != nil || l.tail != nil {
+			t.Fatalf("empty list must have nil head/tail")
+		}
+		return
+	}
+	var last *unrolledNode
+	for node := l.head; node != nil; node = node.next {
+		if node.count == 0 || node.next != nil && node.count < unrolledCap/2 {
+			t.Fatalf("node with %d values is not half full", node.count)
+		}
+		last = node
+	}
+	if last != l.tail {
+		t.Fatalf("last node is not tail")
+	}
+}
+
+func TestUnrolledList(t *testing.T) {
+	want := make([]int, 0, 40)
+	list := &UnrolledList{}
+	for value := range 40 {
+		list.AddInTail(value % 5)
+		want = append(want, value%5)
+	}
+	checkUnrolledList(t, list, want)
+
+	if indx, err := list.Find(3); indx != 3 || err != nil {
+		t.Errorf("failed: find, got %d, err %v", indx, err)
+	}
+	if _, err := list.Find(7); !errors.Is(err, ErrNotFound) {
+		t.Errorf("failed: find missing value, err %v", err)
+	}
+	if got := list.FindAll(4); len(got) != 8 || got[1] != 9 {
+		t.Errorf("failed: find all, got %v", got)
+	}
+
+	// inserting into full node splits it
+	list.Insert(3, 100)
+	list.InsertFirst(-1)
+	want = slices.Insert(want, 3, 100)
+	want = slices.Insert(want, 0, -1)
+	checkUnrolledList(t, list, want)
+	if err := list.Insert(len(want)+1, 0); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("failed: insert out of range, err %v", err)
+	}
+
+	list.Delete(100, false)
+	list.Delete(0, true)
+	want = slices.DeleteFunc(slices.Delete(want, 4, 5), func(v int) bool { return v == 0 })
+	checkUnrolledList(t, list, want)
+
+	list.Delete(1, true)
+	list.Delete(2, true)
+	list.Delete(3, true)
+	checkUnrolledList(t, list, []int{-1, 4, 4, 4, 4, 4, 4, 4, 4})
+	list.Clean()
+	checkUnrolledList(t, list, nil)
+}
+
+func BenchmarkUnrolledVsLinked(b *testing.B) {
+	for _, size := range []int{1e3, 1e4, 1e5, 1e6} {
+		values := make([]int, size)
+		for i := range values {
+			values[i] = i
+		}
+		linked, unrolled := GetLinkedList(values), GetUnrolledList(values)
+
+		b.Run(fmt.Sprintf("build/linked/%d", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				GetLinkedList(values)
+			}
+		})
+		b.Run(fmt.Sprintf("build/unrolled/%d", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				GetUnrolledList(values)
+			}
+		})
+		b.Run(fmt.Sprintf("find/linked/%d", size), func(b *testing.B) {
+			for b.Loop() {
+				linked.Find(size - 1)
+			}
+		})
+		b.Run(fmt.Sprintf("find/unrolled/%d", size), func(b *testing.B) {
+			for b.Loop() {
+				unrolled.Find(size - 1)
+			}
+		})
+		b.Run(fmt.Sprintf("findall/linked/%d", size), func(b *testing.B) {
+			for b.Loop() {
+				linked.FindAll(size / 2)
+			}
+		})
+		b.Run(fmt.Sprintf("findall/unrolled/%d", size), func(b *testing.B) {
+			for b.Loop() {
+				unrolled.FindAll(size / 2)
+			}
+		})
+		// delete and insert back the middle value, so list keeps its size
+		b.Run(fmt.Sprintf("delete+insert/linked/%d", size), func(b *testing.B) {
+			for b.Loop() {
+				linked.Delete(size/2, false)
+				after, _ := linked.Find(size/2 - 1)
+				linked.Insert(after, Node{value: size / 2})
+			}
+		})
+		b.Run(fmt.Sprintf("delete+insert/unrolled/%d", size), func(b *testing.B) {
+			for b.Loop() {
+				unrolled.Delete(size/2, false)
+				unrolled.Insert(size/2, size/2)
+			}
+		})
+	}
+}
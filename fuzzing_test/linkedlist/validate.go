@@ -0,0 +1,88 @@
+package linkedlist
+
+import (
+	"fmt"
+)
+
+// Validate checks invariants of the list: head/tail consistency,
+// owner of every node, no cycles and maintained length.
+// It does not change the list, so it may run together with other readers.
+// It is for debugging and fuzz tests, t = O(n*r), where n = len(list),
+// r - length of owner record chains, which mutators keep short
+func (l *List[T]) Validate() error {
+	if l.head == nil || l.tail == nil {
+		if l.head != l.tail {
+			return fmt.Errorf("%w: only one of head and tail is nil", ErrBrokenList)
+		}
+		if l.length != 0 {
+			return fmt.Errorf("%w: empty list has length %d", ErrBrokenList, l.length)
+		}
+		return nil
+	}
+	if l.tail.next != nil {
+		return fmt.Errorf("%w: tail.next is not nil", ErrBrokenList)
+	}
+
+	steps := 0
+	var last *ListNode[T]
+	for tempNode := l.head; tempNode != nil; tempNode = tempNode.next {
+		// list without cycle can not be longer than its length
+		if steps == l.length {
+			return fmt.Errorf("%w: more than %d nodes, possible cycle", ErrBrokenList, l.length)
+		}
+		if !l.ownsReadOnly(tempNode) {
+			return fmt.Errorf("%w: node at %d has wrong owner", ErrBrokenList, steps)
+		}
+		last = tempNode
+		steps++
+	}
+	if steps != l.length {
+		return fmt.Errorf("%w: length is %d, but list has %d nodes", ErrBrokenList, l.length, steps)
+	}
+	if last != l.tail {
+		return fmt.Errorf("%w: last node is not tail", ErrBrokenList)
+	}
+	return nil
+}
+
+// Validate checks the same invariants as List.Validate plus prev/next symmetry
+func (l *DoublyList[T]) Validate() error {
+	if l.head == nil || l.tail == nil {
+		if l.head != l.tail {
+			return fmt.Errorf("%w: only one of head and tail is nil", ErrBrokenList)
+		}
+		if l.length != 0 {
+			return fmt.Errorf("%w: empty list has length %d", ErrBrokenList, l.length)
+		}
+		return nil
+	}
+	if l.head.prev != nil {
+		return fmt.Errorf("%w: head.prev is not nil", ErrBrokenList)
+	}
+	if l.tail.next != nil {
+		return fmt.Errorf("%w: tail.next is not nil", ErrBrokenList)
+	}
+
+	steps := 0
+	var last *DoublyNode[T]
+	for tempNode := l.head; tempNode != nil; tempNode = tempNode.next {
+		if steps == l.length {
+			return fmt.Errorf("%w: more than %d nodes, possible cycle", ErrBrokenList, l.length)
+		}
+		if tempNode.list != l {
+			return fmt.Errorf("%w: node at %d has wrong owner", ErrBrokenList, steps)
+		}
+		if tempNode.prev != last {
+			return fmt.Errorf("%w: prev/next asymmetry at %d", ErrBrokenList, steps)
+		}
+		last = tempNode
+		steps++
+	}
+	if steps != l.length {
+		return fmt.Errorf("%w: length is %d, but list has %d nodes", ErrBrokenList, l.length, steps)
+	}
+	if last != l.tail {
+		return fmt.Errorf("%w: last node is not tail", ErrBrokenList)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package linkedlist
+
+import (
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		corrupt func(l *LinkedList)
+	}{
+		{"Test1: ", func(l *LinkedList) { l.tail = nil }},
+		{"Test2: ", func(l *LinkedList) { l.length++ }},
+		{"Test3: ", func(l *LinkedList) { l.length-- }},
+		{"Test4: ", func(l *LinkedList) { l.tail.next = l.head }},
+		{"Test5: ", func(l *LinkedList) { l.tail = l.head }},
+		{"Test6: ", func(l *LinkedList) { l.head.next.owner = nil }},
+		{"Test7: ", func(l *LinkedList) { l.Clean(); l.length = 1 }},
+	}
+
+	for _, test := range tests {
+		list := GetLinkedList([]int{22, 3, 2})
+		if err := list.Validate(); err != nil {
+			t.Fatalf("failed %s: valid list, err %v", test.name, err)
+		}
+		test.corrupt(list)
+		if err := list.Validate(); !errors.Is(err, ErrBrokenList) {
+			t.Errorf("failed %s: broken list is valid, err %v", test.name, err)
+		}
+	}
+}
+
+func TestValidateReadOnly(t *testing.T) {
+	// chained concat leaves record chains, which owns would shorten
+	list := GetList([]int{1})
+	for value := range 3 {
+		other := GetList([]int{value})
+		other.Concat(list)
+		list = other
+	}
+	// owner record of every node and its parent, root halves both
+	type records struct{ owner, parent *listOwner }
+	var before []records
+	for tempNode := list.head; tempNode != nil; tempNode = tempNode.next {
+		before = append(before, records{tempNode.owner, tempNode.owner.parent})
+	}
+
+	if err := list.Validate(); err != nil {
+		t.Fatalf("failed: valid list, err %v", err)
+	}
+	if err := list.WriteDOT(io.Discard); err != nil {
+		t.Fatalf("failed: write dot, err %v", err)
+	}
+	indx := 0
+	for tempNode := list.head; tempNode != nil; tempNode = tempNode.next {
+		if got := (records{tempNode.owner, tempNode.owner.parent}); got != before[indx] {
+			t.Errorf("failed: owner of node %d changed by read-only methods", indx)
+		}
+		indx++
+	}
+}
+
+func TestValidateDoubly(t *testing.T) {
+	list := GetDoublyList([]int{22, 3, 2})
+	if err := list.Validate(); err != nil {
+		t.Fatalf("failed: valid list, err %v", err)
+	}
+	list.head.next.prev = nil
+	if err := list.Validate(); !errors.Is(err, ErrBrokenList) {
+		t.Errorf("failed: prev/next asymmetry is valid, err %v", err)
+	}
+}
@@ -0,0 +1,100 @@
+package example1
+
+import (
+	"cmp"
+	"minimize_steps/example2"
+)
+
+type Mode int
+
+const (
+	StrictlyIncreasing Mode = iota
+	NonDecreasing
+	StrictlyDecreasing
+	NonIncreasing
+)
+
+// Subsequence keeps values together with their indices in the input,
+// so it is always a real subsequence of it
+type Subsequence[T any] struct {
+	Values  []T
+	Indices []int
+}
+
+// LIS finds one of the longest subsequences, which is monotonous in mode,
+// less defines the order as in slices.SortFunc, but returns bool
+// t = O(n*log(n)), mem = O(n)
+func LIS[T any](array []T, less func(a, b T) bool, mode Mode) Subsequence[T] {
+	less, strict := increasingOrder(less, mode)
+
+	// tails[k] - index of the least tail of subsequences with length k+1,
+	// parents[i] - index of previous element in subsequence ending at i
+	tails := make([]int, 0, len(array))
+	parents := make([]int, len(array))
+
+	for indx, target := range array {
+		tailPos := tailPosition(array, tails, target, less, strict)
+
+		if tailPos == len(tails) {
+			tails = append(tails, indx)
+		} else {
+			tails[tailPos] = indx
+		}
+
+		if tailPos > 0 {
+			parents[indx] = tails[tailPos-1]
+		} else {
+			parents[indx] = -1
+		}
+	}
+
+	result := Subsequence[T]{
+		Values:  make([]T, len(tails)),
+		Indices: make([]int, len(tails)),
+	}
+	if len(tails) == 0 {
+		return result
+	}
+	indx := tails[len(tails)-1]
+	for pos := len(tails) - 1; pos >= 0; pos-- {
+		result.Values[pos] = array[indx]
+		result.Indices[pos] = indx
+		indx = parents[indx]
+	}
+
+	return result
+}
+
+// increasingOrder turns decreasing modes into increasing by swapped less
+func increasingOrder[T any](less func(a, b T) bool, mode Mode) (func(a, b T) bool, bool) {
+	strict := mode == StrictlyIncreasing || mode == StrictlyDecreasing
+	if mode == StrictlyDecreasing || mode == NonIncreasing {
+		return func(a, b T) bool {
+			return less(b, a)
+		}, strict
+	}
+	return less, strict
+}
+
+// fits reports whether next can follow prev in increasing subsequence
+func fits[T any](prev, next T, less func(a, b T) bool, strict bool) bool {
+	if strict {
+		return less(prev, next)
+	}
+	return !less(next, prev)
+}
+
+// tailPosition finds the first pile, whose tail can not be followed by target,
+// tails are sorted, so it is a binary search
+func tailPosition[T any](array []T, tails []int, target T, less func(a, b T) bool, strict bool) int {
+	return example2.PartitionPoint(tails, func(tailIndx int) bool {
+		return fits(array[tailIndx], target, less, strict)
+	})
+}
+
+// LISKey compares elements by extracted key, e.g. by a field of struct
+func LISKey[T any, K cmp.Ordered](array []T, key func(T) K, mode Mode) Subsequence[T] {
+	return LIS(array, func(a, b T) bool {
+		return cmp.Less(key(a), key(b))
+	}, mode)
+}
@@ -0,0 +1,176 @@
+package example1
+
+import (
+	"iter"
+	"math/big"
+	"minimize_steps/example2"
+	"slices"
+)
+
+// subsequences are counted and enumerated by values: equal values
+// at different positions give the same subsequence, values a and b
+// are equal when neither less(a, b) nor less(b, a)
+
+type pileEntry struct {
+	indx  int
+	total *big.Int // counts of subsequences starting at entries of pile up to this one
+}
+
+// CountLIS returns the number of distinct longest subsequences in mode,
+// it is exponential in the worst case, so it is big.Int,
+// empty array has one longest subsequence - the empty one
+// t = O(n*log(n)) big.Int additions, mem = O(n)
+func CountLIS[T any](array []T, less func(a, b T) bool, mode Mode) *big.Int {
+	less, strict := increasingOrder(less, mode)
+	reversedLess := func(a, b T) bool {
+		return less(b, a)
+	}
+
+	// patience sorting from the end: piles[k] - elements starting subsequences
+	// of length k+1, one after another the later entry the greater its value,
+	// so entries which can follow a new element are a suffix of the pile.
+	// Of equal values only the leftmost one is kept: it starts all
+	// subsequences the right ones start, so values are counted once
+	var piles [][]pileEntry
+	tails := make([]int, 0, len(array))
+
+	for indx := len(array) - 1; indx >= 0; indx-- {
+		target := array[indx]
+		tailPos := tailPosition(array, tails, target, reversedLess, strict)
+
+		count := big.NewInt(1)
+		if tailPos > 0 {
+			pile := piles[tailPos-1]
+			first := example2.PartitionPoint(pile, func(entry pileEntry) bool {
+				return !fits(target, array[entry.indx], less, strict)
+			})
+			count.Set(pile[len(pile)-1].total)
+			if first > 0 {
+				count.Sub(count, pile[first-1].total)
+			}
+		}
+
+		if tailPos == len(tails) {
+			tails = append(tails, indx)
+			piles = append(piles, nil)
+		} else {
+			tails[tailPos] = indx
+		}
+		pile := piles[tailPos]
+		if last := len(pile) - 1; last >= 0 && !less(array[pile[last].indx], target) {
+			pile = pile[:last]
+		}
+		if len(pile) > 0 {
+			count.Add(count, pile[len(pile)-1].total)
+		}
+		piles[tailPos] = append(pile, pileEntry{indx: indx, total: count})
+	}
+
+	if len(piles) == 0 {
+		return big.NewInt(1)
+	}
+	last := piles[len(piles)-1]
+	return new(big.Int).Set(last[len(last)-1].total)
+}
+
+// AllLIS iterates distinct longest subsequences in lexicographic order
+// of values by less, every one is given at its leftmost indices,
+// limit <= 0 means no limit
+// t = O(n*log(n)) before the first one, then O(k*n*log(n)) for each, where k = LIS length
+func AllLIS[T any](array []T, less func(a, b T) bool, mode Mode, limit int) iter.Seq[Subsequence[T]] {
+	return func(yield func(Subsequence[T]) bool) {
+		byValue := less
+		less, strict := increasingOrder(less, mode)
+		lengths := lengthsFrom(array, less, strict)
+		size := 0
+		for _, length := range lengths {
+			size = max(size, length)
+		}
+
+		yielded := 0
+		indices := make([]int, 0, size)
+
+		// every element with lengths[j] == need, which fits after prev,
+		// starts a tail of the right length, so the walk has no dead ends;
+		// of equal values the leftmost one starts all the tails the others do
+		var walk func(from, need int) bool
+		walk = func(from, need int) bool {
+			if need == 0 {
+				result := Subsequence[T]{
+					Values:  make([]T, len(indices)),
+					Indices: append([]int{}, indices...),
+				}
+				for pos, indx := range indices {
+					result.Values[pos] = array[indx]
+				}
+				yielded++
+				return yield(result) && (limit <= 0 || yielded < limit)
+			}
+
+			var candidates []int
+			for indx := from; indx < len(array); indx++ {
+				if lengths[indx] != need {
+					continue
+				}
+				if len(indices) > 0 && !fits(array[indices[len(indices)-1]], array[indx], less, strict) {
+					continue
+				}
+				candidates = append(candidates, indx)
+			}
+			// stable sort keeps the leftmost of equal values first
+			slices.SortStableFunc(candidates, func(i, j int) int {
+				return compareBy(array[i], array[j], byValue)
+			})
+			candidates = slices.CompactFunc(candidates, func(i, j int) bool {
+				return compareBy(array[i], array[j], byValue) == 0
+			})
+
+			for _, indx := range candidates {
+				indices = append(indices, indx)
+				next := walk(indx+1, need-1)
+				indices = indices[:len(indices)-1]
+				if !next {
+					return false
+				}
+			}
+			return true
+		}
+
+		walk(0, size)
+	}
+}
+
+func compareBy[T any](a, b T, less func(a, b T) bool) int {
+	switch {
+	case less(a, b):
+		return -1
+	case less(b, a):
+		return 1
+	}
+	return 0
+}
+
+// lengthsFrom returns for every element the length of the longest
+// subsequence starting at it, it is patience sorting from the end
+// t = O(n*log(n)), mem = O(n)
+func lengthsFrom[T any](array []T, less func(a, b T) bool, strict bool) []int {
+	reversedLess := func(a, b T) bool {
+		return less(b, a)
+	}
+
+	tails := make([]int, 0, len(array))
+	lengths := make([]int, len(array))
+
+	for indx := len(array) - 1; indx >= 0; indx-- {
+		tailPos := tailPosition(array, tails, array[indx], reversedLess, strict)
+
+		if tailPos == len(tails) {
+			tails = append(tails, indx)
+		} else {
+			tails[tailPos] = indx
+		}
+		lengths[indx] = tailPos + 1
+	}
+
+	return lengths
+}
@@ -0,0 +1,71 @@
+package example1
+
+import (
+	"cmp"
+	"math/big"
+	"slices"
+	"testing"
+)
+
+// allLISBrute checks every subset of indices and keeps distinct
+// value sequences of the longest ones, sorted lexicographically
+func allLISBrute(array []int, fits func(prev, next int) bool) [][]int {
+	var all [][]int
+	best := 0
+	var walk func(from int, values []int)
+	walk = func(from int, values []int) {
+		if len(values) > best {
+			best, all = len(values), nil
+		}
+		if len(values) == best {
+			all = append(all, slices.Clone(values))
+		}
+		for indx := from; indx < len(array); indx++ {
+			if len(values) == 0 || fits(values[len(values)-1], array[indx]) {
+				walk(indx+1, append(values, array[indx]))
+			}
+		}
+	}
+	walk(0, nil)
+	slices.SortFunc(all, slices.Compare)
+	return slices.CompactFunc(all, slices.Equal)
+}
+
+func FuzzCountLIS(f *testing.F) {
+	f.Add([]byte{}, uint8(0), 0)
+	f.Add([]byte{1, 3, 5, 4, 7}, uint8(0), 0)
+	f.Add([]byte{2, 2, 2, 2}, uint8(1), 2)
+	f.Add([]byte{3, 1, 2, 0}, uint8(2), 1)
+
+	f.Fuzz(func(t *testing.T, data []byte, rawMode uint8, limit int) {
+		if len(data) > 12 {
+			t.Skip()
+		}
+		array := bytesToSmallInts(data)
+		mode := Mode(rawMode % 4)
+		want := allLISBrute(array, modeFits(mode))
+
+		if got := CountLIS(array, cmp.Less[int], mode); got.Cmp(big.NewInt(int64(len(want)))) != 0 {
+			t.Fatalf("CountLIS in mode %d: wanted: %d, got: %v of %v", mode, len(want), got, array)
+		}
+
+		if limit > 0 && limit < len(want) {
+			want = want[:limit]
+		}
+		var got [][]int
+		for sequence := range AllLIS(array, cmp.Less[int], mode, limit) {
+			for pos, indx := range sequence.Indices {
+				if array[indx] != sequence.Values[pos] {
+					t.Fatalf("value %d is not at index %d in %v", sequence.Values[pos], indx, array)
+				}
+				if pos > 0 && indx <= sequence.Indices[pos-1] {
+					t.Fatalf("indices are not increasing: %v", sequence.Indices)
+				}
+			}
+			got = append(got, sequence.Values)
+		}
+		if !slices.EqualFunc(got, want, slices.Equal) {
+			t.Fatalf("AllLIS in mode %d: wanted: %v, got: %v of %v", mode, want, got, array)
+		}
+	})
+}
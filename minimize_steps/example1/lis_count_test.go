@@ -0,0 +1,125 @@
+package example1
+
+import (
+	"cmp"
+	"math/big"
+	"slices"
+	"testing"
+)
+
+func TestCountLIS(t *testing.T) {
+	type countLISCase struct {
+		Name   string
+		Input  []int
+		Mode   Mode
+		Output int64
+	}
+
+	cases := []countLISCase{
+		{
+			"empty array",
+			[]int{},
+			StrictlyIncreasing,
+			1,
+		},
+		{
+			"one element array",
+			[]int{1},
+			StrictlyIncreasing,
+			1,
+		},
+		{
+			"two ways to the end",
+			[]int{1, 3, 5, 4, 7},
+			StrictlyIncreasing,
+			2,
+		},
+		{
+			"all values equal",
+			[]int{2, 2, 2, 2, 2},
+			StrictlyIncreasing,
+			1,
+		},
+		{
+			"equal values at different positions",
+			[]int{1, 2, 1, 2},
+			StrictlyIncreasing,
+			1,
+		},
+		{
+			"equal values, non decreasing",
+			[]int{1, 2, 1, 2},
+			NonDecreasing,
+			2,
+		},
+		{
+			"all values equal, non decreasing",
+			[]int{2, 2, 2, 2, 2},
+			NonDecreasing,
+			1,
+		},
+		{
+			"strictly decreasing",
+			[]int{3, 1, 2, 0},
+			StrictlyDecreasing,
+			2,
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			result := CountLIS(testCase.Input, cmp.Less[int], testCase.Mode)
+			if result.Cmp(big.NewInt(testCase.Output)) != 0 {
+				subT.Fatalf("FAILED: %s, wanted: %v, got: %v", testCase.Name, testCase.Output, result)
+			}
+		})
+	}
+}
+
+func TestCountLISOverflow(t *testing.T) {
+	// pairs 1 0 3 2 5 4 ... give 2 choices for every position
+	array := make([]int, 200)
+	for i := range array {
+		array[i] = i ^ 1
+	}
+
+	result := CountLIS(array, cmp.Less[int], StrictlyIncreasing)
+	output := new(big.Int).Lsh(big.NewInt(1), 100)
+	if result.Cmp(output) != 0 {
+		t.Fatalf("FAILED: overflow, wanted: %v, got: %v", output, result)
+	}
+}
+
+func TestAllLIS(t *testing.T) {
+	array := []int{1, 3, 2, 4, 0, 4, 2}
+
+	var result, indices [][]int
+	for sequence := range AllLIS(array, cmp.Less[int], StrictlyIncreasing, 0) {
+		result = append(result, sequence.Values)
+		indices = append(indices, sequence.Indices)
+	}
+	output := [][]int{{1, 2, 4}, {1, 3, 4}}
+	if !slices.EqualFunc(result, output, slices.Equal) {
+		t.Fatalf("FAILED: all, wanted: %v, got: %v", output, result)
+	}
+	outputIndices := [][]int{{0, 2, 3}, {0, 1, 3}}
+	if !slices.EqualFunc(indices, outputIndices, slices.Equal) {
+		t.Fatalf("FAILED: leftmost indices, wanted: %v, got: %v", outputIndices, indices)
+	}
+
+	result = nil
+	for sequence := range AllLIS(array, cmp.Less[int], StrictlyIncreasing, 1) {
+		result = append(result, sequence.Values)
+	}
+	if !slices.EqualFunc(result, output[:1], slices.Equal) {
+		t.Fatalf("FAILED: limit, wanted: %v, got: %v", output[:1], result)
+	}
+
+	result = nil
+	for sequence := range AllLIS([]int{2, 2, 2}, cmp.Less[int], StrictlyIncreasing, 0) {
+		result = append(result, sequence.Values)
+	}
+	if !slices.EqualFunc(result, [][]int{{2}}, slices.Equal) {
+		t.Fatalf("FAILED: equal values, wanted: %v, got: %v", [][]int{{2}}, result)
+	}
+}
@@ -0,0 +1,83 @@
+package example1
+
+import (
+	"cmp"
+	"testing"
+)
+
+// lisLenDP is the O(n^2) oracle: best[i] - length of the longest
+// subsequence ending at i
+func lisLenDP(array []int, fits func(prev, next int) bool) int {
+	best := make([]int, len(array))
+	result := 0
+	for i := range array {
+		best[i] = 1
+		for j := range i {
+			if fits(array[j], array[i]) {
+				best[i] = max(best[i], best[j]+1)
+			}
+		}
+		result = max(result, best[i])
+	}
+	return result
+}
+
+func modeFits(mode Mode) func(prev, next int) bool {
+	switch mode {
+	case NonDecreasing:
+		return func(prev, next int) bool { return prev <= next }
+	case StrictlyDecreasing:
+		return func(prev, next int) bool { return prev > next }
+	case NonIncreasing:
+		return func(prev, next int) bool { return prev >= next }
+	default:
+		return func(prev, next int) bool { return prev < next }
+	}
+}
+
+// small values, so equal values are often
+func bytesToSmallInts(data []byte) []int {
+	array := make([]int, len(data))
+	for i, b := range data {
+		array[i] = int(b%16) - 8
+	}
+	return array
+}
+
+func FuzzLIS(f *testing.F) {
+	f.Add([]byte{}, uint8(0))
+	f.Add([]byte{7, 1, 2, 3, 0, 4, 5, 6, 5}, uint8(0))
+	f.Add([]byte{1, 3, 4, 0, 2}, uint8(0))
+	f.Add([]byte{5, 1, 4, 4, 2, 3}, uint8(3))
+
+	f.Fuzz(func(t *testing.T, data []byte, rawMode uint8) {
+		if len(data) > 500 {
+			t.Skip()
+		}
+		array := bytesToSmallInts(data)
+		mode := Mode(rawMode % 4)
+		fits := modeFits(mode)
+
+		result := LIS(array, cmp.Less[int], mode)
+		if len(result.Values) != len(result.Indices) {
+			t.Fatalf("values and indices differ: %v %v", result.Values, result.Indices)
+		}
+		for pos, indx := range result.Indices {
+			if indx < 0 || indx >= len(array) || array[indx] != result.Values[pos] {
+				t.Fatalf("value %d is not at index %d in %v", result.Values[pos], indx, array)
+			}
+			if pos > 0 && (indx <= result.Indices[pos-1] || !fits(result.Values[pos-1], result.Values[pos])) {
+				t.Fatalf("not a subsequence in mode %d: %v at %v of %v", mode, result.Values, result.Indices, array)
+			}
+		}
+		if want := lisLenDP(array, fits); len(result.Values) != want {
+			t.Fatalf("length in mode %d: wanted: %d, got: %v of %v", mode, want, result.Values, array)
+		}
+
+		if mode == StrictlyIncreasing && len(array) > 1 {
+			if got := StrictlyMonotonousSequence(array); len(got) != len(result.Values) {
+				t.Fatalf("StrictlyMonotonousSequence: wanted: %v, got: %v", result.Values, got)
+			}
+		}
+	})
+}
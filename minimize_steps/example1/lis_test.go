@@ -0,0 +1,92 @@
+package example1
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestLIS(t *testing.T) {
+	type lisCase struct {
+		Name    string
+		Input   []int
+		Mode    Mode
+		Values  []int
+		Indices []int
+	}
+
+	cases := []lisCase{
+		{
+			"empty array",
+			[]int{},
+			StrictlyIncreasing,
+			[]int{},
+			[]int{},
+		},
+		{
+			"strictly increasing skips equal values",
+			[]int{1, 2, 2, 3},
+			StrictlyIncreasing,
+			[]int{1, 2, 3},
+			[]int{0, 2, 3},
+		},
+		{
+			"non decreasing keeps equal values",
+			[]int{1, 2, 2, 3},
+			NonDecreasing,
+			[]int{1, 2, 2, 3},
+			[]int{0, 1, 2, 3},
+		},
+		{
+			"strictly decreasing",
+			[]int{5, 1, 4, 4, 2, 3},
+			StrictlyDecreasing,
+			[]int{5, 4, 3},
+			[]int{0, 3, 5},
+		},
+		{
+			"non increasing",
+			[]int{5, 1, 4, 4, 2, 3},
+			NonIncreasing,
+			[]int{5, 4, 4, 3},
+			[]int{0, 2, 3, 5},
+		},
+		{
+			"parent replaced after use",
+			[]int{1, 3, 4, 0, 2},
+			StrictlyIncreasing,
+			[]int{1, 3, 4},
+			[]int{0, 1, 2},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			result := LIS(testCase.Input, cmp.Less[int], testCase.Mode)
+			if slices.Compare(result.Values, testCase.Values) != 0 {
+				subT.Fatalf("FAILED: %s, wanted: %v, got: %v", testCase.Name, testCase.Values, result.Values)
+			}
+			if slices.Compare(result.Indices, testCase.Indices) != 0 {
+				subT.Fatalf("FAILED: %s, wanted: %v, got: %v", testCase.Name, testCase.Indices, result.Indices)
+			}
+		})
+	}
+}
+
+func TestLISKey(t *testing.T) {
+	words := []string{"go", "a", "fuzz", "test", "binary", "search"}
+	result := LISKey(words, func(word string) int { return len(word) }, NonDecreasing)
+
+	output := []string{"a", "fuzz", "test", "binary", "search"}
+	if slices.Compare(result.Values, output) != 0 {
+		t.Fatalf("FAILED: by length, wanted: %v, got: %v", output, result.Values)
+	}
+
+	words = []string{"b", "A", "c", "a", "B"}
+	result = LIS(words, func(a, b string) bool { return strings.ToLower(a) < strings.ToLower(b) }, StrictlyIncreasing)
+	output = []string{"a", "B"}
+	if slices.Compare(result.Values, output) != 0 {
+		t.Fatalf("FAILED: by less, wanted: %v, got: %v", output, result.Values)
+	}
+}
@@ -33,6 +33,11 @@ func TestStrictlyMonotonousSequence(t *testing.T) {
 			[]int{1, 2, 3, 4, 5, 6},
 			[]int{1, 2, 3, 4, 5, 6},
 		},
+		{
+			"parent replaced after use",
+			[]int{1, 3, 4, 0, 2},
+			[]int{1, 3, 4},
+		},
 	}
 
 	for _, testCase := range cases {
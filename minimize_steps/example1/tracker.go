@@ -0,0 +1,122 @@
+package example1
+
+import (
+	"minimize_steps/example2"
+)
+
+// LISTracker keeps strictly increasing LIS of pushed values,
+// Len and Sequence give the same as StrictlyMonotonousSequence
+// of all pushed values, or of the last window values in window mode.
+// Only the mode without window is incremental: window mode is a batch
+// recompute of the whole window on the first query after eviction,
+// see NewLISTracker and BenchmarkLISTracker for the cost.
+// Zero value is a tracker without window
+type LISTracker struct {
+	window int
+
+	values   []int // pushed values, only the last window ones in window mode
+	tails    []int // tails[k] - the least tail value of subsequences with length k+1
+	tailIndx []int // tailIndx[k] - index of tails[k] in values
+	parents  []int // parents[i] - index of previous element in subsequence ending at i
+
+	// evicted value may be in the middle of every subsequence,
+	// so after eviction piles are rebuilt on the next query
+	stale bool
+}
+
+// window <= 0 means all values are kept, and every Push is O(log(n)).
+//
+// Window mode is not incremental: once the window is full, every Push
+// evicts the oldest value, which may be in the middle of every subsequence,
+// so the next Len or Sequence rebuilds the piles of the whole window
+// in O(w*log(w)), where w = window. A feed which queries after every Push
+// costs O(w*log(w)) per value, so it is for small windows or rare queries
+func NewLISTracker(window int) *LISTracker {
+	return &LISTracker{window: window}
+}
+
+// Push adds the next value of the feed
+// t = O(log(n)), in window mode after eviction t = O(1)
+// and the next query is O(w*log(w)), where w = window
+func (t *LISTracker) Push(value int) {
+	if t.window > 0 && len(t.values) == t.window {
+		t.values = append(t.values[1:], value)
+		t.stale = true
+		return
+	}
+
+	t.values = append(t.values, value)
+	if !t.stale {
+		t.place(len(t.values) - 1)
+	}
+}
+
+// t = O(1), in window mode after eviction it is batch recompute, O(w*log(w))
+func (t *LISTracker) Len() int {
+	t.refresh()
+	return len(t.tails)
+}
+
+// Sequence returns the current LIS as StrictlyMonotonousSequence does
+// t = O(k), where k = LIS length,
+// in window mode after eviction it is batch recompute, O(w*log(w))
+func (t *LISTracker) Sequence() []int {
+	t.refresh()
+
+	size := len(t.tails)
+	result := make([]int, size)
+	if size == 0 {
+		return result
+	}
+	indx := t.tailIndx[size-1]
+	for pos := size - 1; pos >= 0; pos-- {
+		result[pos] = t.values[indx]
+		indx = t.parents[indx]
+	}
+
+	return result
+}
+
+// Reset drops all values, window is kept
+func (t *LISTracker) Reset() {
+	t.values = t.values[:0]
+	t.tails = t.tails[:0]
+	t.tailIndx = t.tailIndx[:0]
+	t.parents = t.parents[:0]
+	t.stale = false
+}
+
+// place puts values[indx] on its pile, as StrictlyMonotonousSequence does
+func (t *LISTracker) place(indx int) {
+	target := t.values[indx]
+	tailPos := example2.BinarySearchLeft(t.tails, target)
+
+	if tailPos == len(t.tails) {
+		t.tails = append(t.tails, target)
+		t.tailIndx = append(t.tailIndx, indx)
+	} else {
+		t.tails[tailPos] = target
+		t.tailIndx[tailPos] = indx
+	}
+
+	if tailPos > 0 {
+		t.parents = append(t.parents, t.tailIndx[tailPos-1])
+	} else {
+		t.parents = append(t.parents, -1)
+	}
+}
+
+// refresh is the batch recompute of window mode: piles of the whole window
+// are built again, as StrictlyMonotonousSequence does
+func (t *LISTracker) refresh() {
+	if !t.stale {
+		return
+	}
+	t.tails = t.tails[:0]
+	t.tailIndx = t.tailIndx[:0]
+	t.parents = t.parents[:0]
+	for indx := range t.values {
+		t.place(indx)
+	}
+	t.stale = false
+}
@@ -0,0 +1,38 @@
+package example1
+
+import (
+	"slices"
+	"testing"
+)
+
+func FuzzLISTracker(f *testing.F) {
+	f.Add([]byte{}, uint8(0))
+	f.Add([]byte{7, 1, 2, 3, 0, 4, 5, 6, 5}, uint8(0))
+	f.Add([]byte{1, 2, 3, 4, 5, 0, 1, 2}, uint8(4))
+
+	f.Fuzz(func(t *testing.T, data []byte, rawWindow uint8) {
+		if len(data) > 300 {
+			t.Skip()
+		}
+		array := bytesToSmallInts(data)
+		window := int(rawWindow % 16)
+		tracker := NewLISTracker(window)
+
+		// the same result as batch call at every prefix,
+		// queries are skipped sometimes to check lazy rebuild
+		for end := range array {
+			tracker.Push(array[end])
+			if array[end]%3 == 0 {
+				continue
+			}
+			start := 0
+			if window > 0 {
+				start = max(0, end+1-window)
+			}
+			want := StrictlyMonotonousSequence(slices.Clone(array[start : end+1]))
+			if got := tracker.Sequence(); !slices.Equal(got, want) || tracker.Len() != len(want) {
+				t.Fatalf("prefix %d with window %d: wanted: %v, got: %v of %v", end+1, window, want, got, array)
+			}
+		}
+	})
+}
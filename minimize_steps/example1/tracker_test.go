@@ -0,0 +1,86 @@
+package example1
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestLISTracker(t *testing.T) {
+	type lisTrackerCase struct {
+		Name   string
+		Window int
+		Input  []int
+		Output []int
+	}
+
+	cases := []lisTrackerCase{
+		{
+			"empty feed",
+			0,
+			[]int{},
+			[]int{},
+		},
+		{
+			"whole feed",
+			0,
+			[]int{7, 1, 2, 3, 0, 4, 5, 6, 5},
+			[]int{1, 2, 3, 4, 5, 6},
+		},
+		{
+			"window keeps the last values",
+			4,
+			[]int{1, 2, 3, 4, 5, 0, 1, 2},
+			[]int{0, 1, 2},
+		},
+		{
+			"window is larger than feed",
+			10,
+			[]int{3, 1, 2},
+			[]int{1, 2},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			tracker := NewLISTracker(testCase.Window)
+			for _, value := range testCase.Input {
+				tracker.Push(value)
+			}
+			result := tracker.Sequence()
+			if slices.Compare(result, testCase.Output) != 0 || tracker.Len() != len(testCase.Output) {
+				subT.Fatalf("FAILED: %s, wanted: %v, got: %v", testCase.Name, testCase.Output, result)
+			}
+
+			tracker.Reset()
+			tracker.Push(1)
+			if result := tracker.Sequence(); slices.Compare(result, []int{1}) != 0 {
+				subT.Fatalf("FAILED: %s after reset, wanted: %v, got: %v", testCase.Name, []int{1}, result)
+			}
+		})
+	}
+}
+
+// BenchmarkLISTracker is Push and Len of a feed which queries after every value:
+// without window it is O(log(n)) per value, in window mode every query
+// after eviction is batch recompute, so time per value grows with window
+func BenchmarkLISTracker(b *testing.B) {
+	feed := make([]int, 1<<16)
+	for i := range feed {
+		feed[i] = (i * 7919) % 10007
+	}
+
+	for _, window := range []int{0, 16, 256, 4096} {
+		b.Run(fmt.Sprintf("window/%d", window), func(b *testing.B) {
+			tracker := NewLISTracker(window)
+			for i := range b.N {
+				// the feed starts again, so tracker without window does not grow with b.N
+				if i%len(feed) == 0 {
+					tracker.Reset()
+				}
+				tracker.Push(feed[i%len(feed)])
+				tracker.Len()
+			}
+		})
+	}
+}
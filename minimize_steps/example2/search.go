@@ -0,0 +1,98 @@
+package example2
+
+import (
+	"cmp"
+)
+
+// all searches expect array sorted in the order of their cmp and
+// return insert position in [0, len(array)], as slices.BinarySearch does
+
+// PartitionPoint returns the first index where pred is false,
+// pred must be true for a prefix of array and false for the rest
+// t = O(log(n)), one pred call per step
+func PartitionPoint[T any](array []T, pred func(T) bool) int {
+	left, right := 0, len(array)
+
+	for left < right {
+		middle := int(uint(left+right) >> 1)
+
+		if pred(array[middle]) {
+			left = middle + 1
+		} else {
+			right = middle
+		}
+	}
+
+	return left
+}
+
+// ordered versions compare with operators instead of calls,
+// so they are as fast as slices.BinarySearch, but NaN is not ordered,
+// for float arrays with NaN use Func versions with cmp.Compare
+
+// LowerBound returns the first index where array[i] >= target
+func LowerBound[T cmp.Ordered](array []T, target T) int {
+	left, right := 0, len(array)
+
+	for left < right {
+		middle := int(uint(left+right) >> 1)
+
+		if array[middle] < target {
+			left = middle + 1
+		} else {
+			right = middle
+		}
+	}
+
+	return left
+}
+
+// UpperBound returns the first index where array[i] > target
+func UpperBound[T cmp.Ordered](array []T, target T) int {
+	left, right := 0, len(array)
+
+	for left < right {
+		middle := int(uint(left+right) >> 1)
+
+		if array[middle] <= target {
+			left = middle + 1
+		} else {
+			right = middle
+		}
+	}
+
+	return left
+}
+
+// EqualRange returns [lower, upper) of values equal to target
+func EqualRange[T cmp.Ordered](array []T, target T) (int, int) {
+	lower := LowerBound(array, target)
+	return lower, lower + UpperBound(array[lower:], target)
+}
+
+// cmp(element, target) is in style of slices.BinarySearchFunc
+func LowerBoundFunc[E, T any](array []E, target T, cmp func(E, T) int) int {
+	return PartitionPoint(array, func(element E) bool {
+		return cmp(element, target) < 0
+	})
+}
+
+func UpperBoundFunc[E, T any](array []E, target T, cmp func(E, T) int) int {
+	return PartitionPoint(array, func(element E) bool {
+		return cmp(element, target) <= 0
+	})
+}
+
+// upper bound is searched only to the right of lower bound
+func EqualRangeFunc[E, T any](array []E, target T, cmp func(E, T) int) (int, int) {
+	lower := LowerBoundFunc(array, target, cmp)
+	upper := lower + UpperBoundFunc(array[lower:], target, cmp)
+	return lower, upper
+}
+
+// Descending is cmp for arrays sorted from max to min,
+// e.g. LowerBoundFunc(array, target, Descending[int])
+// returns the first index where array[i] <= target
+func Descending[T cmp.Ordered](a, b T) int {
+	return cmp.Compare(b, a)
+}
@@ -0,0 +1,70 @@
+package example2
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+// linear scans are the oracle for every search
+func linearPartitionPoint(array []int, pred func(int) bool) int {
+	for indx, value := range array {
+		if !pred(value) {
+			return indx
+		}
+	}
+	return len(array)
+}
+
+func FuzzSearchFamily(f *testing.F) {
+	f.Add([]byte{}, int8(1), false)
+	f.Add([]byte{1, 3, 5, 7, 9}, int8(4), false)
+	f.Add([]byte{1, 2, 2, 2, 3}, int8(2), true)
+
+	f.Fuzz(func(t *testing.T, data []byte, rawTarget int8, descending bool) {
+		if len(data) > 1000 {
+			t.Skip()
+		}
+		// small values, so runs of equal values are often
+		array := make([]int, len(data))
+		for i, b := range data {
+			array[i] = int(b % 32)
+		}
+		target := int(rawTarget % 34)
+
+		compare := cmp.Compare[int]
+		if descending {
+			compare = Descending[int]
+		}
+		slices.SortFunc(array, compare)
+
+		wantLower := linearPartitionPoint(array, func(v int) bool { return compare(v, target) < 0 })
+		wantUpper := linearPartitionPoint(array, func(v int) bool { return compare(v, target) <= 0 })
+
+		if got := LowerBoundFunc(array, target, compare); got != wantLower {
+			t.Fatalf("LowerBound of %d: wanted: %d, got: %d in %v", target, wantLower, got, array)
+		}
+		if got := UpperBoundFunc(array, target, compare); got != wantUpper {
+			t.Fatalf("UpperBound of %d: wanted: %d, got: %d in %v", target, wantUpper, got, array)
+		}
+		if lower, upper := EqualRangeFunc(array, target, compare); lower != wantLower || upper != wantUpper {
+			t.Fatalf("EqualRange of %d: wanted: [%d, %d), got: [%d, %d) in %v", target, wantLower, wantUpper, lower, upper, array)
+		}
+
+		if descending {
+			return
+		}
+		if got := LowerBound(array, target); got != wantLower {
+			t.Fatalf("LowerBound of %d: wanted: %d, got: %d in %v", target, wantLower, got, array)
+		}
+		if got := UpperBound(array, target); got != wantUpper {
+			t.Fatalf("UpperBound of %d: wanted: %d, got: %d in %v", target, wantUpper, got, array)
+		}
+		if got := BinarySearchLeft(array, target); got != wantLower {
+			t.Fatalf("BinarySearchLeft of %d: wanted: %d, got: %d in %v", target, wantLower, got, array)
+		}
+		if got, found := slices.BinarySearch(array, target); got != wantLower || found != (wantLower < wantUpper) {
+			t.Fatalf("slices.BinarySearch of %d: wanted: %d, got: %d in %v", target, wantLower, got, array)
+		}
+	})
+}
@@ -0,0 +1,133 @@
+package example2
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestSearchFamily(t *testing.T) {
+	type searchCase struct {
+		Name       string
+		InputArray []int
+		Target     int
+		Lower      int
+		Upper      int
+	}
+
+	cases := []searchCase{
+		{
+			"empty array",
+			[]int{},
+			1,
+			0,
+			0,
+		},
+		{
+			"target before all",
+			[]int{1, 3, 5},
+			0,
+			0,
+			0,
+		},
+		{
+			"target after all",
+			[]int{1, 3, 5},
+			6,
+			3,
+			3,
+		},
+		{
+			"missing target",
+			[]int{1, 3, 5},
+			4,
+			2,
+			2,
+		},
+		{
+			"run of equal values",
+			[]int{1, 2, 2, 2, 3},
+			2,
+			1,
+			4,
+		},
+		{
+			"all values equal",
+			[]int{7, 7, 7},
+			7,
+			0,
+			3,
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			lower, upper := EqualRange(testCase.InputArray, testCase.Target)
+			if lower != testCase.Lower || LowerBound(testCase.InputArray, testCase.Target) != testCase.Lower {
+				subT.Fatalf("FAILED: %s, wanted: %v, got: %d", testCase.Name, testCase.Lower, lower)
+			}
+			if upper != testCase.Upper || UpperBound(testCase.InputArray, testCase.Target) != testCase.Upper {
+				subT.Fatalf("FAILED: %s, wanted: %v, got: %d", testCase.Name, testCase.Upper, upper)
+			}
+		})
+	}
+}
+
+func TestDescendingAndPartitionPoint(t *testing.T) {
+	array := []int{9, 7, 7, 4, 1}
+
+	lower, upper := EqualRangeFunc(array, 7, Descending[int])
+	if lower != 1 || upper != 3 {
+		t.Fatalf("FAILED: descending equal range, wanted: [1, 3), got: [%d, %d)", lower, upper)
+	}
+	if resultIndx := LowerBoundFunc(array, 5, Descending[int]); resultIndx != 3 {
+		t.Fatalf("FAILED: descending lower bound, wanted: 3, got: %d", resultIndx)
+	}
+
+	type point struct{ x, y int }
+	points := []point{{1, 2}, {2, 0}, {3, 5}, {4, 1}}
+	resultIndx := PartitionPoint(points, func(p point) bool { return p.x < 3 })
+	if resultIndx != 2 {
+		t.Fatalf("FAILED: partition point, wanted: 2, got: %d", resultIndx)
+	}
+}
+
+func BenchmarkSearch(b *testing.B) {
+	for _, size := range []int{16, 1024, 1 << 20} {
+		array := make([]int, size)
+		for i := range array {
+			array[i] = 2 * i
+		}
+		targets := make([]int, 1024)
+		for i := range targets {
+			targets[i] = (i * 7919) % (2 * size)
+		}
+
+		b.Run(fmt.Sprintf("LowerBound/%d", size), func(b *testing.B) {
+			for i := range b.N {
+				LowerBound(array, targets[i%len(targets)])
+			}
+		})
+		b.Run(fmt.Sprintf("LowerBoundFunc/%d", size), func(b *testing.B) {
+			for i := range b.N {
+				LowerBoundFunc(array, targets[i%len(targets)], cmp.Compare[int])
+			}
+		})
+		b.Run(fmt.Sprintf("BinarySearchLeft/%d", size), func(b *testing.B) {
+			for i := range b.N {
+				BinarySearchLeft(array, targets[i%len(targets)])
+			}
+		})
+		b.Run(fmt.Sprintf("slices.BinarySearch/%d", size), func(b *testing.B) {
+			for i := range b.N {
+				_, _ = slices.BinarySearch(array, targets[i%len(targets)])
+			}
+		})
+		b.Run(fmt.Sprintf("EqualRange/%d", size), func(b *testing.B) {
+			for i := range b.N {
+				EqualRange(array, targets[i%len(targets)])
+			}
+		})
+	}
+}
@@ -1,20 +1,6 @@
 package example2
 
+// one comparison per step, see LowerBound
 func BinarySearchLeft(array []int, target int) int {
-	left, right := 0, len(array)
-
-	for left < right {
-		middle := (left + right) / 2
-
-		if target > array[middle] {
-			left = middle + 1
-		}
-
-		if target <= array[middle] {
-			right = middle
-		}
-
-	}
-
-	return left
+	return LowerBound(array, target)
 }
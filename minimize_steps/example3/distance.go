@@ -0,0 +1,97 @@
+package example3
+
+import (
+	"slices"
+)
+
+type EditOp int
+
+const (
+	Keep EditOp = iota
+	Substitute
+	Insert
+	Delete
+	Transpose
+)
+
+// Edit is one step of script, which turns a into b, steps go in order of a:
+//
+//	Keep, Substitute - a[IndexA] becomes b[IndexB]
+//	Insert           - b[IndexB] is inserted before a[IndexA]
+//	Delete           - a[IndexA] is deleted before b[IndexB]
+//	Transpose        - a[IndexA], a[IndexA+1] become b[IndexB], b[IndexB+1]
+type Edit struct {
+	Op     EditOp
+	IndexA int
+	IndexB int
+}
+
+// Levenshtein returns the least number of insertions, deletions and
+// substitutions, which turn a into b, and the script of them
+// t = O(n*m), mem = O(n*m)
+func Levenshtein[T comparable](a, b []T) (int, []Edit) {
+	return editDistance(a, b, false)
+}
+
+// Damerau is Levenshtein with transpositions of two adjacent elements,
+// it is the optimal string alignment version: a transposed pair is not
+// edited again, so e.g. "ca" -> "abc" is 3, not 2
+// t = O(n*m), mem = O(n*m)
+func Damerau[T comparable](a, b []T) (int, []Edit) {
+	return editDistance(a, b, true)
+}
+
+// dist[i][j] - distance between a[:i] and b[:j]
+func editDistance[T comparable](a, b []T, transpose bool) (int, []Edit) {
+	dist := make([][]int, len(a)+1)
+	for i := range dist {
+		dist[i] = make([]int, len(b)+1)
+		dist[i][0] = i
+	}
+	for j := range dist[0] {
+		dist[0][j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			dist[i][j] = min(dist[i-1][j-1]+cost, dist[i-1][j]+1, dist[i][j-1]+1)
+			if transpose && isTransposed(a, b, i, j) {
+				dist[i][j] = min(dist[i][j], dist[i-2][j-2]+1)
+			}
+		}
+	}
+
+	// back from the end, every step repeats one of minimums above
+	var script []Edit
+	i, j := len(a), len(b)
+	for i > 0 || j > 0 {
+		switch {
+		case i > 0 && j > 0 && a[i-1] == b[j-1] && dist[i][j] == dist[i-1][j-1]:
+			script = append(script, Edit{Op: Keep, IndexA: i - 1, IndexB: j - 1})
+			i, j = i-1, j-1
+		case i > 0 && j > 0 && dist[i][j] == dist[i-1][j-1]+1:
+			script = append(script, Edit{Op: Substitute, IndexA: i - 1, IndexB: j - 1})
+			i, j = i-1, j-1
+		case transpose && isTransposed(a, b, i, j) && dist[i][j] == dist[i-2][j-2]+1:
+			script = append(script, Edit{Op: Transpose, IndexA: i - 2, IndexB: j - 2})
+			i, j = i-2, j-2
+		case i > 0 && dist[i][j] == dist[i-1][j]+1:
+			script = append(script, Edit{Op: Delete, IndexA: i - 1, IndexB: j})
+			i--
+		default:
+			script = append(script, Edit{Op: Insert, IndexA: i, IndexB: j - 1})
+			j--
+		}
+	}
+	slices.Reverse(script)
+
+	return dist[len(a)][len(b)], script
+}
+
+func isTransposed[T comparable](a, b []T, i, j int) bool {
+	return i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1]
+}
@@ -0,0 +1,110 @@
+package example3
+
+import (
+	"slices"
+	"testing"
+)
+
+// applyScript runs script on a and checks that it goes over a in order
+func applyScript[T any](a, b []T, script []Edit) ([]T, bool) {
+	var result []T
+	nextA := 0
+	for _, edit := range script {
+		if edit.IndexA != nextA {
+			return nil, false
+		}
+		switch edit.Op {
+		case Keep, Substitute:
+			result = append(result, b[edit.IndexB])
+			nextA++
+		case Insert:
+			result = append(result, b[edit.IndexB])
+		case Delete:
+			nextA++
+		case Transpose:
+			result = append(result, a[edit.IndexA+1], a[edit.IndexA])
+			nextA += 2
+		}
+	}
+	return result, nextA == len(a)
+}
+
+func scriptCost(script []Edit) int {
+	cost := 0
+	for _, edit := range script {
+		if edit.Op != Keep {
+			cost++
+		}
+	}
+	return cost
+}
+
+func TestEditDistance(t *testing.T) {
+	type editDistanceCase struct {
+		Name        string
+		InputA      string
+		InputB      string
+		Levenshtein int
+		Damerau     int
+	}
+
+	cases := []editDistanceCase{
+		{
+			"empty strings",
+			"",
+			"",
+			0,
+			0,
+		},
+		{
+			"insert all",
+			"",
+			"abc",
+			3,
+			3,
+		},
+		{
+			"kitten",
+			"kitten",
+			"sitting",
+			3,
+			3,
+		},
+		{
+			"adjacent swap",
+			"abcd",
+			"acbd",
+			2,
+			1,
+		},
+		{
+			"transposed pair is not edited again",
+			"ca",
+			"abc",
+			3,
+			3,
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			a, b := []rune(testCase.InputA), []rune(testCase.InputB)
+			for _, distance := range []struct {
+				name   string
+				wanted int
+				find   func(a, b []rune) (int, []Edit)
+			}{
+				{"levenshtein", testCase.Levenshtein, Levenshtein[rune]},
+				{"damerau", testCase.Damerau, Damerau[rune]},
+			} {
+				result, script := distance.find(a, b)
+				if result != distance.wanted || scriptCost(script) != result {
+					subT.Fatalf("FAILED: %s %s, wanted: %v, got: %v, script: %v", testCase.Name, distance.name, distance.wanted, result, script)
+				}
+				if applied, ok := applyScript(a, b, script); !ok || slices.Compare(applied, b) != 0 {
+					subT.Fatalf("FAILED: %s %s, script gives: %v", testCase.Name, distance.name, string(applied))
+				}
+			}
+		})
+	}
+}
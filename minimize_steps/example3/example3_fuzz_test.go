@@ -0,0 +1,151 @@
+package example3
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+)
+
+// naive DPs are the oracles, dist[i][j] - answer for a[:i] and b[:j]
+
+func lcsLenDP(a, b []byte) int {
+	dist := make([][]int, len(a)+1)
+	for i := range dist {
+		dist[i] = make([]int, len(b)+1)
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if a[i-1] == b[j-1] {
+				dist[i][j] = dist[i-1][j-1] + 1
+			} else {
+				dist[i][j] = max(dist[i-1][j], dist[i][j-1])
+			}
+		}
+	}
+	return dist[len(a)][len(b)]
+}
+
+// editDistanceDP is written by recurrence from scratch, not by editDistance
+func editDistanceDP(a, b []byte, transpose bool) int {
+	dist := make([][]int, len(a)+1)
+	for i := range dist {
+		dist[i] = make([]int, len(b)+1)
+	}
+	for i := 0; i <= len(a); i++ {
+		for j := 0; j <= len(b); j++ {
+			switch {
+			case i == 0:
+				dist[i][j] = j
+			case j == 0:
+				dist[i][j] = i
+			case a[i-1] == b[j-1]:
+				dist[i][j] = dist[i-1][j-1]
+			default:
+				dist[i][j] = 1 + min(dist[i-1][j-1], dist[i-1][j], dist[i][j-1])
+			}
+			if transpose && i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] && a[i-1] != b[j-1] {
+				dist[i][j] = min(dist[i][j], dist[i-2][j-2]+1)
+			}
+		}
+	}
+	return dist[len(a)][len(b)]
+}
+
+// small alphabet, so inputs have a lot in common
+func smallAlphabet(data []byte) []byte {
+	result := make([]byte, len(data))
+	for i, b := range data {
+		result[i] = 'a' + b%4
+	}
+	return result
+}
+
+func FuzzLCS(f *testing.F) {
+	f.Add([]byte{}, []byte{})
+	f.Add([]byte("ABCBDAB"), []byte("BDCABA"))
+	f.Add([]byte("aaaa"), []byte("aa"))
+
+	f.Fuzz(func(t *testing.T, dataA []byte, dataB []byte) {
+		if len(dataA) > 200 || len(dataB) > 200 {
+			t.Skip()
+		}
+		a, b := smallAlphabet(dataA), smallAlphabet(dataB)
+
+		result := LCS(a, b)
+		if want := lcsLenDP(a, b); len(result.Values) != want {
+			t.Fatalf("LCS length: wanted: %d, got: %q of %q %q", want, result.Values, a, b)
+		}
+		for pos, value := range result.Values {
+			if a[result.IndicesA[pos]] != value || b[result.IndicesB[pos]] != value {
+				t.Fatalf("LCS value %q is not at %d, %d", value, result.IndicesA[pos], result.IndicesB[pos])
+			}
+			if pos > 0 && (result.IndicesA[pos] <= result.IndicesA[pos-1] || result.IndicesB[pos] <= result.IndicesB[pos-1]) {
+				t.Fatalf("LCS indices are not increasing: %v %v", result.IndicesA, result.IndicesB)
+			}
+		}
+	})
+}
+
+func FuzzEditDistance(f *testing.F) {
+	f.Add([]byte{}, []byte{})
+	f.Add([]byte("kitten"), []byte("sitting"))
+	f.Add([]byte("abcd"), []byte("acbd"))
+	f.Add([]byte("ca"), []byte("abc"))
+
+	f.Fuzz(func(t *testing.T, dataA []byte, dataB []byte) {
+		if len(dataA) > 100 || len(dataB) > 100 {
+			t.Skip()
+		}
+		a, b := smallAlphabet(dataA), smallAlphabet(dataB)
+
+		for _, transpose := range []bool{false, true} {
+			find := Levenshtein[byte]
+			if transpose {
+				find = Damerau[byte]
+			}
+			result, script := find(a, b)
+			if want := editDistanceDP(a, b, transpose); result != want {
+				t.Fatalf("distance with transpose %v: wanted: %d, got: %d of %q %q", transpose, want, result, a, b)
+			}
+			if scriptCost(script) != result {
+				t.Fatalf("script cost with transpose %v: wanted: %d, got: %v", transpose, result, script)
+			}
+			if applied, ok := applyScript(a, b, script); !ok || !slices.Equal(applied, b) {
+				t.Fatalf("script with transpose %v gives %q, wanted: %q", transpose, applied, b)
+			}
+		}
+	})
+}
+
+func FuzzDiff(f *testing.F) {
+	f.Add([]byte{}, []byte{})
+	f.Add([]byte("abc"), []byte("axc"))
+	f.Add([]byte("abcabba"), []byte("cbabac"))
+
+	f.Fuzz(func(t *testing.T, dataA []byte, dataB []byte) {
+		if len(dataA) > 200 || len(dataB) > 200 {
+			t.Skip()
+		}
+		a, b := smallAlphabet(dataA), smallAlphabet(dataB)
+		linesA, linesB := make([]string, len(a)), make([]string, len(b))
+		for i, value := range a {
+			linesA[i] = "line " + strconv.Itoa(int(value))
+		}
+		for i, value := range b {
+			linesB[i] = "line " + strconv.Itoa(int(value))
+		}
+
+		script := Diff(linesA, linesB)
+		if want := len(a) + len(b) - 2*lcsLenDP(a, b); scriptCost(script) != want {
+			t.Fatalf("Diff is not the shortest: wanted: %d changes, got: %v of %q %q", want, script, a, b)
+		}
+		if applied, ok := applyScript(linesA, linesB, script); !ok || !slices.Equal(applied, linesB) {
+			t.Fatalf("Diff gives %q, wanted: %q", applied, linesB)
+		}
+		for _, edit := range script {
+			if edit.Op == Keep && linesA[edit.IndexA] != linesB[edit.IndexB] {
+				t.Fatalf("Diff keeps different lines %d and %d", edit.IndexA, edit.IndexB)
+			}
+		}
+	})
+}
@@ -0,0 +1,54 @@
+package example3
+
+import (
+	"minimize_steps/example1"
+)
+
+// Common is a common subsequence with indices of its values in both inputs
+type Common[T any] struct {
+	Values   []T
+	IndicesA []int
+	IndicesB []int
+}
+
+type match struct {
+	indxA int
+	indxB int
+}
+
+// LCS is Hunt-Szymanski: all pairs of equal elements are listed by indxA,
+// pairs of one indxA by decreasing indxB, so a strictly increasing by indxB
+// subsequence of pairs takes every element of a at most once,
+// and the longest one is the longest common subsequence
+// t = O((n+r)*log(n)), mem = O(n+m+r), where r = number of equal pairs
+func LCS[T comparable](a, b []T) Common[T] {
+	positions := make(map[T][]int)
+	for indxB, value := range b {
+		positions[value] = append(positions[value], indxB)
+	}
+
+	var matches []match
+	for indxA, value := range a {
+		indicesB := positions[value]
+		for pos := len(indicesB) - 1; pos >= 0; pos-- {
+			matches = append(matches, match{indxA: indxA, indxB: indicesB[pos]})
+		}
+	}
+
+	chain := example1.LIS(matches, func(x, y match) bool {
+		return x.indxB < y.indxB
+	}, example1.StrictlyIncreasing)
+
+	result := Common[T]{
+		Values:   make([]T, len(chain.Values)),
+		IndicesA: make([]int, len(chain.Values)),
+		IndicesB: make([]int, len(chain.Values)),
+	}
+	for pos, pair := range chain.Values {
+		result.Values[pos] = a[pair.indxA]
+		result.IndicesA[pos] = pair.indxA
+		result.IndicesB[pos] = pair.indxB
+	}
+
+	return result
+}
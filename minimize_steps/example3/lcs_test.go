@@ -0,0 +1,56 @@
+package example3
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLCS(t *testing.T) {
+	type lcsCase struct {
+		Name   string
+		InputA []rune
+		InputB []rune
+		Output []rune
+	}
+
+	cases := []lcsCase{
+		{
+			"empty arrays",
+			[]rune{},
+			[]rune{},
+			[]rune{},
+		},
+		{
+			"nothing in common",
+			[]rune("abc"),
+			[]rune("xyz"),
+			[]rune{},
+		},
+		{
+			"classic",
+			[]rune("ABCBDAB"),
+			[]rune("BDCABA"),
+			[]rune("BDAB"),
+		},
+		{
+			"repeated values",
+			[]rune("aaaa"),
+			[]rune("aa"),
+			[]rune("aa"),
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			result := LCS(testCase.InputA, testCase.InputB)
+			if slices.Compare(result.Values, testCase.Output) != 0 {
+				subT.Fatalf("FAILED: %s, wanted: %v, got: %v", testCase.Name, string(testCase.Output), string(result.Values))
+			}
+			for pos, value := range result.Values {
+				if testCase.InputA[result.IndicesA[pos]] != value || testCase.InputB[result.IndicesB[pos]] != value {
+					subT.Fatalf("FAILED: %s, wrong indices: %v %v", testCase.Name, result.IndicesA, result.IndicesB)
+				}
+			}
+		})
+	}
+}
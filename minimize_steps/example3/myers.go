@@ -0,0 +1,80 @@
+package example3
+
+import (
+	"slices"
+)
+
+// Diff is Myers diff, e.g. of lines: the shortest script of Keep, Delete and
+// Insert, which turns a into b, deletions go before insertions
+// t = O((n+m)*d), mem = O(n+m+d*d), where d = number of changed elements
+func Diff[T comparable](a, b []T) []Edit {
+	n, m := len(a), len(b)
+	// one more diagonal on both sides, so window of step n+m fits
+	offset := n + m + 1
+	// furthest[offset+k] - the furthest x on diagonal k = x-y,
+	// trace[d] - furthest of diagonals -d-1..d+1 before step d,
+	// step d reads only them, so trace is O(d*d), not O((n+m)*d)
+	furthest := make([]int, 2*offset+1)
+	var trace [][]int
+
+	for d := 0; d <= n+m; d++ {
+		trace = append(trace, slices.Clone(furthest[offset-d-1:offset+d+2]))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if fromAbove(furthest, offset, k, d) {
+				x = furthest[offset+k+1]
+			} else {
+				x = furthest[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x, y = x+1, y+1
+			}
+			furthest[offset+k] = x
+
+			if x >= n && y >= m {
+				return backtrack(trace, n, m)
+			}
+		}
+	}
+
+	return nil
+}
+
+// fromAbove reports whether diagonal k is reached by insertion from k+1,
+// otherwise it is deletion from k-1
+func fromAbove(furthest []int, offset, k, d int) bool {
+	return k == -d || k != d && furthest[offset+k-1] < furthest[offset+k+1]
+}
+
+func backtrack(trace [][]int, x, y int) []Edit {
+	var script []Edit
+	for d := len(trace) - 1; d >= 0; d-- {
+		// window of step d starts at diagonal -d-1
+		furthest, offset := trace[d], d+1
+		k := x - y
+		prevK := k - 1
+		if fromAbove(furthest, offset, k, d) {
+			prevK = k + 1
+		}
+		prevX := furthest[offset+prevK]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			script = append(script, Edit{Op: Keep, IndexA: x - 1, IndexB: y - 1})
+			x, y = x-1, y-1
+		}
+		if d == 0 {
+			break
+		}
+		if x == prevX {
+			script = append(script, Edit{Op: Insert, IndexA: x, IndexB: y - 1})
+		} else {
+			script = append(script, Edit{Op: Delete, IndexA: x - 1, IndexB: y})
+		}
+		x, y = prevX, prevY
+	}
+	slices.Reverse(script)
+
+	return script
+}
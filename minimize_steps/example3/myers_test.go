@@ -0,0 +1,51 @@
+package example3
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	type diffCase struct {
+		Name   string
+		InputA []string
+		InputB []string
+		Output []Edit
+	}
+
+	cases := []diffCase{
+		{
+			"empty files",
+			[]string{},
+			[]string{},
+			nil,
+		},
+		{
+			"equal files",
+			[]string{"a", "b"},
+			[]string{"a", "b"},
+			[]Edit{{Keep, 0, 0}, {Keep, 1, 1}},
+		},
+		{
+			"changed line",
+			[]string{"a", "b", "c"},
+			[]string{"a", "x", "c"},
+			[]Edit{{Keep, 0, 0}, {Delete, 1, 1}, {Insert, 2, 1}, {Keep, 2, 2}},
+		},
+		{
+			"new file",
+			[]string{},
+			[]string{"a", "b"},
+			[]Edit{{Insert, 0, 0}, {Insert, 0, 1}},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.Name, func(subT *testing.T) {
+			result := Diff(testCase.InputA, testCase.InputB)
+			if !slices.Equal(result, testCase.Output) {
+				subT.Fatalf("FAILED: %s, wanted: %v, got: %v", testCase.Name, testCase.Output, result)
+			}
+		})
+	}
+}